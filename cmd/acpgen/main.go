@@ -0,0 +1,265 @@
+// Command acpgen generates the tag registry of package airport from a JSON
+// tag specification. It is meant to be run by go generate:
+//
+//	//go:generate go run ../cmd/acpgen -spec tags.json -output tags.go
+//
+// The specification is an array of tag definitions in the format
+// airport.LoadTagDefinitions reads, with three additional fields: "name", the
+// Go name of the tag, "enum", the Go type of 4 byte byte strings holding
+// named values, and "skipAccessors", which leaves out the typed Airport
+// methods for tags that have a dedicated API. For every tag acpgen emits a
+// Tag<name> constant, an entry in the registry and, unless skipped, a
+// <name> getter and, for writable tags, a Set<name> setter.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// tagSpec is one entry of the specification.
+type tagSpec struct {
+	Tag           string `json:"tag"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	DataType      string `json:"dataType"`
+	Encryption    string `json:"encryption"`
+	MaxLength     int32  `json:"maxLength"`
+	ReadOnly      bool   `json:"readOnly"`
+	Secret        bool   `json:"secret"`
+	Enum          string `json:"enum"`
+	SkipAccessors bool   `json:"skipAccessors"`
+}
+
+// accessor describes the Go type a tag is read and written as.
+type accessor struct {
+	GoType string
+	Zero   string
+	Getter string
+	Setter string
+	// Enum is set for named value types, which are converted from and to
+	// uint32.
+	Enum bool
+}
+
+var (
+	stringAccessor = accessor{GoType: "string", Zero: `""`, Getter: "Text", Setter: "SetText"}
+	ipAccessor     = accessor{GoType: "net.IP", Zero: "nil", Getter: "IP", Setter: "SetIP"}
+	uint32Accessor = accessor{GoType: "uint32", Zero: "0", Getter: "Uint32", Setter: "SetUint32"}
+	boolAccessor   = accessor{GoType: "bool", Zero: "false", Getter: "Bool", Setter: "SetBool"}
+	bytesAccessor  = accessor{GoType: "[]byte", Zero: "nil", Getter: "Bytes", Setter: "SetBytes"}
+)
+
+// accessorFor returns the accessor for a tag, based on its data type.
+func accessorFor(spec tagSpec) (accessor, error) {
+	if "" != spec.Enum {
+		if "TypeByteString" != spec.DataType || 4 != spec.MaxLength {
+			return accessor{}, fmt.Errorf("%s: enum %s needs a 4 byte TypeByteString", spec.Tag, spec.Enum)
+		}
+
+		if !token.IsIdentifier(spec.Enum) || !token.IsExported(spec.Enum) {
+			return accessor{}, fmt.Errorf("%s: enum %q is not an exported identifier", spec.Tag, spec.Enum)
+		}
+
+		return accessor{GoType: spec.Enum, Zero: "0", Getter: "enum", Setter: "setEnum", Enum: true}, nil
+	}
+
+	switch spec.DataType {
+	case "TypeCharString", "TypePhoneNumber":
+		return stringAccessor, nil
+	case "TypeIPAddress":
+		return ipAccessor, nil
+	case "TypeUnsignedInteger", "TypeLittleEndianUnsignedInteger":
+		return uint32Accessor, nil
+	case "TypeByte":
+		if 1 == spec.MaxLength {
+			return boolAccessor, nil
+		}
+		return bytesAccessor, nil
+	case "TypeByteString":
+		return bytesAccessor, nil
+	}
+
+	return accessor{}, fmt.Errorf("%s: unknown data type %q", spec.Tag, spec.DataType)
+}
+
+// tagData is what the template sees of a tag.
+type tagData struct {
+	tagSpec
+	Accessor     accessor
+	HasAccessors bool
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("acpgen: ")
+
+	specPath := flag.String("spec", "tags.json", "tag specification to read")
+	outputPath := flag.String("output", "tags.go", "file to write")
+	pkg := flag.String("package", "airport", "package name of the generated file")
+	flag.Parse()
+
+	specFile, err := os.ReadFile(*specPath)
+	if nil != err {
+		log.Fatal(err)
+	}
+
+	var specs []tagSpec
+	if err = json.Unmarshal(specFile, &specs); nil != err {
+		log.Fatalf("%s: %v", *specPath, err)
+	}
+
+	source, err := generate(*pkg, specs, strings.Join(append([]string{"acpgen"}, os.Args[1:]...), " "))
+	if nil != err {
+		log.Fatal(err)
+	}
+
+	if err = os.WriteFile(*outputPath, source, 0644); nil != err {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the formatted source of the tag registry.
+func generate(pkg string, specs []tagSpec, command string) ([]byte, error) {
+	data := struct {
+		Command  string
+		Package  string
+		Tags     []tagData
+		ImportIP bool
+		Context  bool
+	}{
+		Command: command,
+		Package: pkg,
+	}
+
+	seenTags := make(map[string]bool)
+	seenNames := make(map[string]bool)
+	for _, spec := range specs {
+		if 4 != len(spec.Tag) {
+			return nil, fmt.Errorf("tag %q is not 4 characters long", spec.Tag)
+		}
+
+		if !token.IsIdentifier(spec.Name) || !token.IsExported(spec.Name) {
+			return nil, fmt.Errorf("%s: name %q is not an exported identifier", spec.Tag, spec.Name)
+		}
+
+		if seenTags[spec.Tag] {
+			return nil, fmt.Errorf("%s: tag is defined twice", spec.Tag)
+		}
+		seenTags[spec.Tag] = true
+
+		if seenNames[spec.Name] {
+			return nil, fmt.Errorf("%s: name %s is used twice", spec.Tag, spec.Name)
+		}
+		seenNames[spec.Name] = true
+
+		if "EncryptionUnencrypted" != spec.Encryption && "EncryptionEncrypted" != spec.Encryption {
+			return nil, fmt.Errorf("%s: unknown encryption %q", spec.Tag, spec.Encryption)
+		}
+
+		acc, err := accessorFor(spec)
+		if nil != err {
+			return nil, err
+		}
+
+		tag := tagData{
+			tagSpec:      spec,
+			Accessor:     acc,
+			HasAccessors: !spec.SkipAccessors,
+		}
+		if tag.HasAccessors {
+			data.Context = true
+			if ipAccessor == acc {
+				data.ImportIP = true
+			}
+		}
+
+		data.Tags = append(data.Tags, tag)
+	}
+
+	var buf bytes.Buffer
+	if err := tagsTemplate.Execute(&buf, data); nil != err {
+		return nil, err
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if nil != err {
+		return nil, fmt.Errorf("formatting generated code: %v", err)
+	}
+
+	return source, nil
+}
+
+var tagsTemplate = template.Must(template.New("tags").Parse(`// Code generated by "{{.Command}}"; DO NOT EDIT.
+
+package {{.Package}}
+
+{{if .Context}}import (
+	"context"
+{{- if .ImportIP}}
+	"net"
+{{- end}}
+)
+{{end}}
+// Tags known to the registry.
+const (
+{{- range .Tags}}
+	// Tag{{.Name}} is the {{printf "%q" .Tag}} tag: {{.Description}}.
+	Tag{{.Name}} = {{printf "%q" .Tag}}
+{{- end}}
+)
+
+var tags = map[string]InfoRecord{
+{{- range .Tags}}
+	Tag{{.Name}}: InfoRecord{
+		MaxLength:   {{.MaxLength}},
+		DataType:    {{.DataType}},
+		Encryption:  {{.Encryption}},
+		Description: {{printf "%q" .Description}},
+		Tag:         Tag{{.Name}},
+{{- if .ReadOnly}}
+		ReadOnly:    true,
+{{- end}}
+{{- if .Secret}}
+		Secret:      true,
+{{- end}}
+	},
+{{- end}}
+}
+{{range .Tags}}{{if .HasAccessors}}
+// {{.Name}} reads the {{.Tag}} property ({{.Description}}).
+func (a *Airport) {{.Name}}(ctx context.Context) ({{.Accessor.GoType}}, error) {
+	infoRecord, err := a.GetProperty(ctx, Tag{{.Name}})
+	if nil != err {
+		return {{.Accessor.Zero}}, err
+	}
+{{if .Accessor.Enum}}
+	value, err := infoRecord.{{.Accessor.Getter}}()
+	if nil != err {
+		return {{.Accessor.Zero}}, err
+	}
+
+	return {{.Accessor.GoType}}(value), nil
+{{- else}}
+	return infoRecord.{{.Accessor.Getter}}()
+{{- end}}
+}
+{{if not .ReadOnly}}
+// Set{{.Name}} writes the {{.Tag}} property ({{.Description}}).
+func (a *Airport) Set{{.Name}}(ctx context.Context, value {{.Accessor.GoType}}) error {
+	infoRecord := GetInfoRecord(Tag{{.Name}})
+	if err := infoRecord.{{.Accessor.Setter}}({{if .Accessor.Enum}}uint32(value){{else}}value{{end}}); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+{{end}}{{end}}{{end}}`))
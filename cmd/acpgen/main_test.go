@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestGeneratedTags regenerates the registry of package airport and compares
+// it with the checked in file, which must be regenerated after editing the
+// specification.
+func TestGeneratedTags(t *testing.T) {
+	specFile, err := os.ReadFile("../../src/tags.json")
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	var specs []tagSpec
+	if err = json.Unmarshal(specFile, &specs); nil != err {
+		t.Fatal(err)
+	}
+
+	source, err := generate("airport", specs, "acpgen -spec tags.json -output tags.go")
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	want, err := os.ReadFile("../../src/tags.go")
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(want, source) {
+		t.Errorf("src/tags.go is out of date, run go generate in src")
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	valid := tagSpec{Tag: "syNm", Name: "StationName", DataType: "TypeCharString", Encryption: "EncryptionEncrypted", MaxLength: 32}
+
+	tests := []struct {
+		name  string
+		specs func(spec tagSpec) []tagSpec
+		err   string
+	}{
+		{"short tag", func(spec tagSpec) []tagSpec { spec.Tag = "syN"; return []tagSpec{spec} }, "4 characters"},
+		{"unexported name", func(spec tagSpec) []tagSpec { spec.Name = "stationName"; return []tagSpec{spec} }, "exported identifier"},
+		{"tag twice", func(spec tagSpec) []tagSpec { other := spec; other.Name = "Other"; return []tagSpec{spec, other} }, "defined twice"},
+		{"name twice", func(spec tagSpec) []tagSpec { other := spec; other.Tag = "syN2"; return []tagSpec{spec, other} }, "used twice"},
+		{"unknown encryption", func(spec tagSpec) []tagSpec { spec.Encryption = "EncryptionAES"; return []tagSpec{spec} }, "unknown encryption"},
+	}
+
+	for _, test := range tests {
+		_, err := generate("airport", test.specs(valid), "acpgen")
+		if nil == err || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: generate() error = %v, want %q", test.name, err, test.err)
+		}
+	}
+}
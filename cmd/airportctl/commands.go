@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+// discoverOptions are added to those of discover, tests point it at a local
+// responder.
+var discoverOptions []airport.DiscoverOption
+
+func (c *cli) get(args []string) error {
+	if 0 == len(args) {
+		return usagef("get needs at least one tag")
+	}
+
+	station, err := c.station()
+	if nil != err {
+		return err
+	}
+
+	info, err := station.GetProperties(c.ctx, args...)
+	if nil != err {
+		return err
+	}
+
+	var missing []string
+	var tags []string
+	for _, tag := range args {
+		if airport.RecordUnsupported == info.State(tag) {
+			missing = append(missing, tag)
+			continue
+		}
+		tags = append(tags, tag)
+	}
+
+	if err = c.writeRecords(info, tags); nil != err {
+		return err
+	}
+
+	if 0 < len(missing) {
+		return fmt.Errorf("%w: station did not return %s", airport.ErrUnknownTag, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func (c *cli) set(args []string) error {
+	if 0 == len(args) {
+		return usagef("set needs at least one TAG=VALUE")
+	}
+
+	values := make(map[string]string)
+	for _, arg := range args {
+		tag, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return usagef("%q is not TAG=VALUE", arg)
+		}
+		values[tag] = value
+	}
+
+	station, err := c.station()
+	if nil != err {
+		return err
+	}
+
+	return station.SetProperties(c.ctx, values)
+}
+
+func (c *cli) dump(args []string) error {
+	if 0 != len(args) {
+		return usagef("dump takes no arguments")
+	}
+
+	station, err := c.station()
+	if nil != err {
+		return err
+	}
+
+	info, err := station.ReadAll(c.ctx)
+	if nil != err {
+		return err
+	}
+
+	var tags []string
+	for _, tag := range info.Tags() {
+		if airport.RecordUnsupported != info.State(tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	return c.writeRecords(info, tags)
+}
+
+func (c *cli) diff(args []string) error {
+	if 1 != len(args) && 2 != len(args) {
+		return usagef("diff needs one or two backup files")
+	}
+
+	older, err := readBackupInfo(args[0])
+	if nil != err {
+		return err
+	}
+
+	var newer *airport.Info
+	if 2 == len(args) {
+		newer, err = readBackupInfo(args[1])
+	} else {
+		var station *airport.Airport
+		station, err = c.station()
+		if nil != err {
+			return err
+		}
+		newer, err = station.ReadAll(c.ctx)
+	}
+	if nil != err {
+		return err
+	}
+
+	return c.writeChanges(airport.Diff(older, newer))
+}
+
+func (c *cli) backup(args []string) error {
+	if 1 < len(args) {
+		return usagef("backup takes at most one file")
+	}
+
+	station, err := c.station()
+	if nil != err {
+		return err
+	}
+
+	// Buffer the backup so a failed read leaves no partial file behind.
+	var buf bytes.Buffer
+	if err = station.Backup(c.ctx, &buf); nil != err {
+		return err
+	}
+
+	if 0 == len(args) || "-" == args[0] {
+		_, err = buf.WriteTo(c.stdout)
+		return err
+	}
+
+	return os.WriteFile(args[0], buf.Bytes(), 0600)
+}
+
+func (c *cli) restore(args []string) error {
+	if 1 != len(args) {
+		return usagef("restore needs a backup file")
+	}
+
+	station, err := c.station()
+	if nil != err {
+		return err
+	}
+
+	if "-" == args[0] {
+		return station.Restore(c.ctx, os.Stdin)
+	}
+
+	file, err := os.Open(args[0])
+	if nil != err {
+		return err
+	}
+	defer file.Close()
+
+	return station.Restore(c.ctx, file)
+}
+
+func (c *cli) reboot(args []string) error {
+	if 0 != len(args) {
+		return usagef("reboot takes no arguments")
+	}
+
+	station, err := c.station()
+	if nil != err {
+		return err
+	}
+
+	return station.Reboot(c.ctx)
+}
+
+func (c *cli) tags(args []string) error {
+	if 0 != len(args) {
+		return usagef("tags takes no arguments")
+	}
+
+	var records []*airport.InfoRecord
+	for _, tag := range airport.RegisteredTags() {
+		records = append(records, airport.GetInfoRecord(tag))
+	}
+
+	return c.writeRegistry(records)
+}
+
+func (c *cli) discover(args []string) error {
+	if 0 != len(args) {
+		return usagef("discover takes no arguments")
+	}
+
+	opts := append([]airport.DiscoverOption{airport.WithDiscoveryWait(c.timeout)}, discoverOptions...)
+	stations, err := airport.Discover(c.ctx, opts...)
+	if nil != err {
+		return err
+	}
+
+	return c.writeStations(stations)
+}
+
+// readBackupInfo reads the records of a backup file.
+func readBackupInfo(path string) (*airport.Info, error) {
+	file, err := os.Open(path)
+	if nil != err {
+		return nil, err
+	}
+	defer file.Close()
+
+	backup, err := airport.ReadBackupFile(file)
+	if nil != err {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return backup.Info()
+}
@@ -0,0 +1,252 @@
+// Command airportctl reads and writes the configuration of AirPort base
+// stations.
+//
+// Usage:
+//
+//	airportctl [flags] command [arguments]
+//
+// The station address and password come from the -address, -password and
+// -password-file flags, or from the AIRPORT_ADDRESS, AIRPORT_PASSWORD and
+// AIRPORT_PASSWORD_FILE environment variables. Run airportctl -h for the list
+// of commands and the exit codes.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+// Exit codes.
+const (
+	exitOK = iota
+	exitError
+	exitUsage
+	exitAuth
+	exitNetwork
+	exitInvalid
+)
+
+// usageError is returned for invalid command lines.
+type usageError struct {
+	message string
+}
+
+func (e *usageError) Error() string {
+	return e.message
+}
+
+func usagef(format string, args ...any) error {
+	return &usageError{message: fmt.Sprintf(format, args...)}
+}
+
+// command is an airportctl subcommand.
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(c *cli, args []string) error
+}
+
+var commands = []command{
+	{"get", "TAG...", "print the values of tags", (*cli).get},
+	{"set", "TAG=VALUE...", "write the values of tags in one request", (*cli).set},
+	{"dump", "", "print every registered tag the station returns", (*cli).dump},
+	{"diff", "BACKUP [BACKUP]", "compare a backup with the station, or two backups", (*cli).diff},
+	{"backup", "[FILE]", "write a backup of the configuration to FILE or stdout", (*cli).backup},
+	{"restore", "FILE", "write a backup to the station, - reads stdin", (*cli).restore},
+	{"reboot", "", "reboot the station", (*cli).reboot},
+	{"tags", "", "list the tag registry", (*cli).tags},
+	{"discover", "", "find base stations on the local network", (*cli).discover},
+}
+
+// cli holds the global flags and the output streams.
+type cli struct {
+	// ctx is cancelled on interrupt.
+	ctx    context.Context
+	stdout io.Writer
+	stderr io.Writer
+
+	address      string
+	password     string
+	passwordFile string
+	timeout      time.Duration
+	format       string
+	showSecrets  bool
+	definitions  string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line args and returns the exit code.
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	c := &cli{stdout: stdout, stderr: stderr}
+
+	flags := flag.NewFlagSet("airportctl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&c.address, "address", os.Getenv("AIRPORT_ADDRESS"), "station `host` name or IP address, optionally with port (AIRPORT_ADDRESS)")
+	flags.StringVar(&c.password, "password", "", "station `password` (AIRPORT_PASSWORD)")
+	flags.StringVar(&c.passwordFile, "password-file", os.Getenv("AIRPORT_PASSWORD_FILE"), "read the password from `file` (AIRPORT_PASSWORD_FILE)")
+	flags.DurationVar(&c.timeout, "timeout", 10*time.Second, "timeout of every request, and how long discover waits for answers")
+	flags.StringVar(&c.format, "format", "table", "output `format`: table, json or hex")
+	flags.BoolVar(&c.showSecrets, "show-secrets", false, "show passwords and keys instead of masking them, in every output format")
+	flags.StringVar(&c.definitions, "definitions", "", "register additional tags from a JSON `file`")
+	flags.Usage = func() {
+		c.usage(flags)
+	}
+
+	if err := flags.Parse(args); nil != err {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	if 0 == flags.NArg() {
+		c.usage(flags)
+		return exitUsage
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	c.ctx = ctx
+
+	err := c.dispatch(flags.Arg(0), flags.Args()[1:])
+	if nil == err {
+		return exitOK
+	}
+
+	fmt.Fprintf(stderr, "airportctl: %v\n", err)
+
+	var usageErr *usageError
+	if errors.As(err, &usageErr) {
+		fmt.Fprintln(stderr, "run airportctl -h for usage")
+	}
+
+	return exitCode(err)
+}
+
+func (c *cli) usage(flags *flag.FlagSet) {
+	fmt.Fprint(c.stderr, "Usage: airportctl [flags] command [arguments]\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(c.stderr, "  %-8s %-16s %s\n", cmd.name, cmd.args, cmd.summary)
+	}
+
+	fmt.Fprint(c.stderr, "\nFlags:\n")
+	flags.PrintDefaults()
+
+	fmt.Fprint(c.stderr, `
+Exit codes:
+  0  success
+  1  other errors
+  2  invalid command line
+  3  the station rejected the password
+  4  the station could not be reached or timed out
+  5  unknown tag, read-only tag or invalid value
+`)
+}
+
+func (c *cli) dispatch(name string, args []string) error {
+	switch c.format {
+	case "table", "json", "hex":
+	default:
+		return usagef("unknown format %q", c.format)
+	}
+
+	if "" != c.definitions {
+		if err := c.loadDefinitions(); nil != err {
+			return err
+		}
+	}
+
+	for _, cmd := range commands {
+		if name == cmd.name {
+			return cmd.run(c, args)
+		}
+	}
+
+	return usagef("unknown command %q", name)
+}
+
+func (c *cli) loadDefinitions() error {
+	file, err := os.Open(c.definitions)
+	if nil != err {
+		return err
+	}
+	defer file.Close()
+
+	return airport.LoadTagDefinitions(file)
+}
+
+// station returns a client for the configured station.
+func (c *cli) station() (*airport.Airport, error) {
+	if "" == c.address {
+		return nil, usagef("no station address, use -address or AIRPORT_ADDRESS")
+	}
+
+	password, err := c.readPassword()
+	if nil != err {
+		return nil, err
+	}
+
+	return airport.New(c.address, airport.WithPassword(password), airport.WithTimeout(c.timeout))
+}
+
+// readPassword prefers -password, then the password file, then
+// AIRPORT_PASSWORD.
+func (c *cli) readPassword() (string, error) {
+	if "" != c.password {
+		return c.password, nil
+	}
+
+	if "" != c.passwordFile {
+		contents, err := os.ReadFile(c.passwordFile)
+		if nil != err {
+			return "", err
+		}
+
+		return strings.TrimRight(string(contents), "\r\n"), nil
+	}
+
+	return os.Getenv("AIRPORT_PASSWORD"), nil
+}
+
+// exitCode maps err to one of the exit codes.
+func exitCode(err error) int {
+	var usageErr *usageError
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+
+	switch {
+	case errors.As(err, &usageErr):
+		return exitUsage
+	case errors.Is(err, airport.ErrAuthenticationFailed):
+		return exitAuth
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, syscall.ECONNREFUSED),
+		errors.Is(err, syscall.ECONNRESET),
+		errors.Is(err, os.ErrDeadlineExceeded),
+		errors.As(err, &opErr),
+		errors.As(err, &dnsErr):
+		return exitNetwork
+	case errors.Is(err, airport.ErrUnknownTag),
+		errors.Is(err, airport.ErrReadOnlyTag),
+		errors.Is(err, airport.ErrInvalidValue),
+		errors.Is(err, airport.ErrTypeMismatch):
+		return exitInvalid
+	}
+
+	return exitError
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	airport "github.com/jutaz/go-airport/src"
+	"github.com/jutaz/go-airport/src/airporttest"
+)
+
+// newStation starts a mock station holding a name, a channel, a secret and
+// the firmware version.
+func newStation(t *testing.T) *airporttest.Server {
+	t.Helper()
+
+	server, err := airporttest.NewServer("secret", nil)
+	if nil != err {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { server.Close() })
+
+	for tag, value := range map[string]string{"syNm": "Office", "raCh": "6", "syPW": "hunter2", "buil": "7.6.8"} {
+		infoRecord := airport.GetInfoRecord(tag)
+		if err := infoRecord.SetBytesFromString(value); nil != err {
+			t.Fatal(err)
+		}
+		server.Put(infoRecord)
+	}
+
+	return server
+}
+
+// runCommand runs airportctl against server and returns the exit code and
+// the output.
+func runCommand(server *airporttest.Server, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	if nil != server {
+		args = append([]string{"-address", server.Addr().String(), "-password", "secret", "-timeout", "2s"}, args...)
+	}
+
+	code := run(args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestGet(t *testing.T) {
+	server := newStation(t)
+
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{"table", []string{"syNm  Office", "raCh  6", "syPW  ********"}},
+		{"json", []string{`"syNm": {`, `"value": "Office"`, `"value": 6`, `"value": "********"`}},
+		{"hex", []string{"syNm 4f6666696365", "raCh 00000006", "syPW ********"}},
+	}
+
+	for _, test := range tests {
+		code, stdout, stderr := runCommand(server, "-format", test.format, "get", "syNm", "raCh", "syPW")
+		if exitOK != code {
+			t.Fatalf("%s: exit code %d: %s", test.format, code, stderr)
+		}
+
+		for _, want := range test.want {
+			if !strings.Contains(stdout, want) {
+				t.Errorf("%s: output does not contain %q:\n%s", test.format, want, stdout)
+			}
+		}
+		if strings.Contains(stdout, "hunter2") || strings.Contains(stdout, "68756e74657232") {
+			t.Errorf("%s: output shows the secret:\n%s", test.format, stdout)
+		}
+
+		_, stdout, _ = runCommand(server, "-format", test.format, "-show-secrets", "get", "syPW")
+		if !strings.Contains(stdout, "hunter2") && !strings.Contains(stdout, "68756e74657232") {
+			t.Errorf("%s: -show-secrets output does not show the secret:\n%s", test.format, stdout)
+		}
+	}
+
+	var decoded map[string]json.RawMessage
+	_, stdout, _ := runCommand(server, "-format", "json", "get", "syNm")
+	if err := json.Unmarshal([]byte(stdout), &decoded); nil != err || 1 != len(decoded) {
+		t.Errorf("json output = %s, %v", stdout, err)
+	}
+
+	// tags the station does not return fail after printing the others
+	code, stdout, _ := runCommand(server, "get", "syNm", "waIP")
+	if exitInvalid != code || !strings.Contains(stdout, "Office") {
+		t.Errorf("get of a missing tag exit code %d:\n%s", code, stdout)
+	}
+}
+
+func TestSet(t *testing.T) {
+	server := newStation(t)
+
+	if code, _, stderr := runCommand(server, "set", "syNm=Lab", "raCh=11"); exitOK != code {
+		t.Fatalf("exit code %d: %s", code, stderr)
+	}
+
+	if got := server.Get("syNm"); nil == got || "Lab" != got.String() {
+		t.Errorf("syNm = %v, want Lab", got)
+	}
+	if got := server.Get("raCh"); nil == got || "11" != got.String() {
+		t.Errorf("raCh = %v, want 11", got)
+	}
+}
+
+func TestDump(t *testing.T) {
+	server := newStation(t)
+
+	for _, format := range []string{"table", "json", "hex"} {
+		code, stdout, stderr := runCommand(server, "-format", format, "dump")
+		if exitOK != code {
+			t.Fatalf("%s: exit code %d: %s", format, code, stderr)
+		}
+		if !strings.Contains(stdout, "syNm") || !strings.Contains(stdout, "raCh") {
+			t.Errorf("%s: output misses tags:\n%s", format, stdout)
+		}
+		if strings.Contains(stdout, "hunter2") || strings.Contains(stdout, "68756e74657232") {
+			t.Errorf("%s: output shows the secret:\n%s", format, stdout)
+		}
+	}
+}
+
+func TestBackupDiffRestore(t *testing.T) {
+	server := newStation(t)
+	dir := t.TempDir()
+	before := filepath.Join(dir, "before.json")
+	after := filepath.Join(dir, "after.json")
+
+	if code, _, stderr := runCommand(server, "backup", before); exitOK != code {
+		t.Fatalf("backup exit code %d: %s", code, stderr)
+	}
+
+	if code, _, stderr := runCommand(server, "set", "syNm=Lab", "syPW=letmein"); exitOK != code {
+		t.Fatalf("set exit code %d: %s", code, stderr)
+	}
+
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{"table", []string{"Office", "Lab", "********"}},
+		{"json", []string{`"tag": "syNm"`, `"old": "Office"`, `"new": "Lab"`, `"new": "********"`}},
+		{"hex", []string{"syNm 4f6666696365 4c6162", "syPW ******** ********"}},
+	}
+	for _, test := range tests {
+		code, stdout, stderr := runCommand(server, "-format", test.format, "diff", before)
+		if exitOK != code {
+			t.Fatalf("%s: diff exit code %d: %s", test.format, code, stderr)
+		}
+
+		for _, want := range test.want {
+			if !strings.Contains(stdout, want) {
+				t.Errorf("%s: diff output does not contain %q:\n%s", test.format, want, stdout)
+			}
+		}
+		if strings.Contains(stdout, "letmein") || strings.Contains(stdout, "6c65746d65696e") {
+			t.Errorf("%s: diff output shows the secret:\n%s", test.format, stdout)
+		}
+	}
+
+	// a backup written to stdout diffs against the file
+	code, stdout, stderr := runCommand(server, "backup")
+	if exitOK != code {
+		t.Fatalf("backup to stdout exit code %d: %s", code, stderr)
+	}
+	if err := os.WriteFile(after, []byte(stdout), 0600); nil != err {
+		t.Fatal(err)
+	}
+	if code, stdout, _ := runCommand(nil, "diff", before, after); exitOK != code || !strings.Contains(stdout, "Lab") {
+		t.Errorf("diff of two backups exit code %d:\n%s", code, stdout)
+	}
+
+	if code, _, stderr := runCommand(server, "restore", before); exitOK != code {
+		t.Fatalf("restore exit code %d: %s", code, stderr)
+	}
+	if got := server.Get("syNm"); nil == got || "Office" != got.String() {
+		t.Errorf("restored syNm = %v, want Office", got)
+	}
+	if got := server.Get("syPW"); nil == got || "hunter2" != got.String() {
+		t.Errorf("restored syPW = %v, want hunter2", got)
+	}
+}
+
+func TestReboot(t *testing.T) {
+	if code, _, stderr := runCommand(newStation(t), "reboot"); exitOK != code {
+		t.Errorf("exit code %d: %s", code, stderr)
+	}
+}
+
+func TestTags(t *testing.T) {
+	code, stdout, _ := runCommand(nil, "tags")
+	if exitOK != code || !strings.Contains(stdout, "syPW") || !strings.Contains(stdout, "secret") {
+		t.Errorf("tags exit code %d:\n%s", code, stdout)
+	}
+
+	var decoded []map[string]any
+	code, stdout, _ = runCommand(nil, "-format", "json", "tags")
+	if err := json.Unmarshal([]byte(stdout), &decoded); exitOK != code || nil != err || 0 == len(decoded) {
+		t.Errorf("tags -format json exit code %d, %v:\n%s", code, err, stdout)
+	}
+
+	if code, _, _ := runCommand(nil, "-format", "hex", "tags"); exitUsage != code {
+		t.Errorf("tags -format hex exit code %d, want %d", code, exitUsage)
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	station := airport.StationInfo{Name: "Office", MAC: net.HardwareAddr{0, 0x11, 0x22, 0x33, 0x44, 0x55}, Model: "AirPort"}
+	responder, err := airporttest.NewDiscoveryResponder(station)
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer responder.Close()
+
+	discoverOptions = []airport.DiscoverOption{airport.WithDiscoveryTarget(responder.Addr())}
+	defer func() { discoverOptions = nil }()
+
+	for _, format := range []string{"table", "json", "hex"} {
+		code, stdout, stderr := runCommand(nil, "-format", format, "-timeout", "200ms", "discover")
+		if exitOK != code {
+			t.Fatalf("%s: exit code %d: %s", format, code, stderr)
+		}
+		if "hex" != format && (!strings.Contains(stdout, "Office") || !strings.Contains(stdout, "00:11:22:33:44:55")) {
+			t.Errorf("%s: output misses the station:\n%s", format, stdout)
+		}
+		if "hex" == format && !strings.Contains(stdout, "001122334455") {
+			t.Errorf("%s: output misses the station:\n%s", format, stdout)
+		}
+	}
+}
+
+func TestExitCodes(t *testing.T) {
+	server := newStation(t)
+
+	// a port nothing listens on
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if nil != err {
+		t.Fatal(err)
+	}
+	unreachable := listener.Addr().String()
+	listener.Close()
+
+	tests := []struct {
+		name string
+		args []string
+		code int
+	}{
+		{"no command", nil, exitUsage},
+		{"unknown command", []string{"frobnicate"}, exitUsage},
+		{"unknown flag", []string{"-frobnicate", "get", "syNm"}, exitUsage},
+		{"unknown format", []string{"-format", "xml", "get", "syNm"}, exitUsage},
+		{"no address", []string{"-address", "", "get", "syNm"}, exitUsage},
+		{"get without tags", []string{"-address", server.Addr().String(), "get"}, exitUsage},
+		{"set without =", []string{"-address", server.Addr().String(), "set", "syNm"}, exitUsage},
+		{"wrong password", []string{"-address", server.Addr().String(), "-password", "wrong", "get", "syNm"}, exitAuth},
+		{"unreachable", []string{"-address", unreachable, "-password", "secret", "get", "syNm"}, exitNetwork},
+		{"unknown tag", []string{"-address", server.Addr().String(), "-password", "secret", "set", "zzzz=1"}, exitInvalid},
+		{"read-only tag", []string{"-address", server.Addr().String(), "-password", "secret", "set", "buil=7.6.8"}, exitInvalid},
+		{"invalid value", []string{"-address", server.Addr().String(), "-password", "secret", "set", "raCh=x"}, exitInvalid},
+		{"missing backup", []string{"diff", filepath.Join(t.TempDir(), "missing.json"), "x"}, exitError},
+	}
+
+	for _, test := range tests {
+		code, _, stderr := runCommand(nil, test.args...)
+		if test.code != code {
+			t.Errorf("%s: exit code %d, want %d: %s", test.name, code, test.code, stderr)
+		}
+	}
+}
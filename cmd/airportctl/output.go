@@ -0,0 +1,302 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+// masked replaces the values of secret tags.
+const masked = "********"
+
+// writeRecords prints the records of info for tags. Secrets are masked in
+// every format unless -show-secrets is given.
+func (c *cli) writeRecords(info *airport.Info, tags []string) error {
+	switch c.format {
+	case "json":
+		// only the requested tags, Info.MarshalJSON would write them all
+		selected := make(map[string]json.RawMessage)
+		for _, tag := range tags {
+			var values []json.RawMessage
+			if airport.RecordPresent == info.State(tag) {
+				for _, infoRecord := range info.GetAll(tag) {
+					encoded, err := c.recordJSON(infoRecord)
+					if nil != err {
+						return err
+					}
+					values = append(values, encoded)
+				}
+			}
+
+			var value any
+			switch len(values) {
+			case 0:
+			case 1:
+				value = values[0]
+			default:
+				value = values
+			}
+
+			encoded, err := json.Marshal(value)
+			if nil != err {
+				return err
+			}
+			selected[tag] = encoded
+		}
+
+		return c.writeJSON(selected)
+	case "hex":
+		for _, tag := range tags {
+			for _, infoRecord := range info.GetAll(tag) {
+				value := hex.EncodeToString(infoRecord.Value)
+				if c.isSecret(tag) && !c.showSecrets {
+					value = maskValue(value)
+				}
+
+				if _, err := fmt.Fprintf(c.stdout, "%s %s\n", tag, value); nil != err {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	w := tabwriter.NewWriter(c.stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "TAG\tVALUE\tDESCRIPTION")
+	for _, tag := range tags {
+		// a line per table row
+		for _, infoRecord := range info.GetAll(tag) {
+			value := infoRecord.String()
+			switch {
+			case airport.RecordInvalid == info.State(tag):
+				value = "<invalid>"
+			case c.isSecret(tag) && !c.showSecrets:
+				value = masked
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\n", tag, value, airport.GetInfoRecord(tag).Description)
+		}
+	}
+
+	return w.Flush()
+}
+
+// changeJSON is the JSON form of a change.
+type changeJSON struct {
+	Tag  string `json:"tag"`
+	Kind string `json:"kind"`
+	Old  string `json:"old,omitempty"`
+	New  string `json:"new,omitempty"`
+}
+
+// writeChanges prints the result of a diff. Secrets are masked unless
+// -show-secrets is given.
+func (c *cli) writeChanges(changes []airport.Change) error {
+	switch c.format {
+	case "json":
+		encoded := make([]changeJSON, 0, len(changes))
+		for _, change := range changes {
+			oldValue, newValue := change.OldValue(), change.NewValue()
+			if c.isSecret(change.Tag) && !c.showSecrets {
+				oldValue, newValue = maskValue(oldValue), maskValue(newValue)
+			}
+
+			encoded = append(encoded, changeJSON{
+				Tag:  change.Tag,
+				Kind: change.Kind.String(),
+				Old:  oldValue,
+				New:  newValue,
+			})
+		}
+
+		return c.writeJSON(encoded)
+	case "hex":
+		for _, change := range changes {
+			oldValue, newValue := hexValue(change.OldRows), hexValue(change.NewRows)
+			if c.isSecret(change.Tag) && !c.showSecrets {
+				oldValue, newValue = maskHex(oldValue), maskHex(newValue)
+			}
+
+			if _, err := fmt.Fprintf(c.stdout, "%s %s %s %s\n", change.Kind, change.Tag, oldValue, newValue); nil != err {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if c.showSecrets {
+		return airport.WriteDiff(c.stdout, changes, airport.WithSecrets())
+	}
+
+	return airport.WriteDiff(c.stdout, changes)
+}
+
+// tagJSON is the JSON form of a registered tag, in the format -definitions
+// reads.
+type tagJSON struct {
+	Tag         string                   `json:"tag"`
+	Description string                   `json:"description"`
+	DataType    airport.RecordType       `json:"dataType"`
+	Encryption  airport.RecordEncryption `json:"encryption"`
+	MaxLength   int32                    `json:"maxLength"`
+	ReadOnly    bool                     `json:"readOnly,omitempty"`
+	Secret      bool                     `json:"secret,omitempty"`
+}
+
+// writeRegistry prints registered tags.
+func (c *cli) writeRegistry(records []*airport.InfoRecord) error {
+	switch c.format {
+	case "json":
+		encoded := make([]tagJSON, 0, len(records))
+		for _, infoRecord := range records {
+			encoded = append(encoded, tagJSON{
+				Tag:         infoRecord.Tag,
+				Description: infoRecord.Description,
+				DataType:    infoRecord.DataType,
+				Encryption:  infoRecord.Encryption,
+				MaxLength:   infoRecord.MaxLength,
+				ReadOnly:    infoRecord.ReadOnly,
+				Secret:      infoRecord.Secret,
+			})
+		}
+
+		return c.writeJSON(encoded)
+	case "hex":
+		return usagef("tags has no hex output")
+	}
+
+	w := tabwriter.NewWriter(c.stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "TAG\tTYPE\tENCRYPTION\tMAX\tFLAGS\tDESCRIPTION")
+	for _, infoRecord := range records {
+		flags := "-"
+		switch {
+		case infoRecord.ReadOnly && infoRecord.Secret:
+			flags = "read-only,secret"
+		case infoRecord.ReadOnly:
+			flags = "read-only"
+		case infoRecord.Secret:
+			flags = "secret"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\n", infoRecord.Tag, infoRecord.DataType, infoRecord.Encryption,
+			infoRecord.MaxLength, flags, infoRecord.Description)
+	}
+
+	return w.Flush()
+}
+
+// stationJSON is the JSON form of a discovered station.
+type stationJSON struct {
+	Address string `json:"address"`
+	Name    string `json:"name,omitempty"`
+	MAC     string `json:"mac,omitempty"`
+	Model   string `json:"model,omitempty"`
+}
+
+// writeStations prints the stations Discover found.
+func (c *cli) writeStations(stations []airport.StationInfo) error {
+	switch c.format {
+	case "json":
+		encoded := make([]stationJSON, 0, len(stations))
+		for _, station := range stations {
+			encoded = append(encoded, stationJSON{
+				Address: station.Address.String(),
+				Name:    station.Name,
+				MAC:     station.MAC.String(),
+				Model:   station.Model,
+			})
+		}
+
+		return c.writeJSON(encoded)
+	case "hex":
+		for _, station := range stations {
+			data, err := station.MarshalBinary()
+			if nil != err {
+				return err
+			}
+
+			if _, err = fmt.Fprintln(c.stdout, hex.EncodeToString(data)); nil != err {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	w := tabwriter.NewWriter(c.stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "ADDRESS\tNAME\tMAC\tMODEL")
+	for _, station := range stations {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", station.Address, station.Name, station.MAC, station.Model)
+	}
+
+	return w.Flush()
+}
+
+func (c *cli) writeJSON(v any) error {
+	encoder := json.NewEncoder(c.stdout)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(v)
+}
+
+// isSecret reports whether tag is registered as secret.
+func (c *cli) isSecret(tag string) bool {
+	return airport.GetInfoRecord(tag).Secret
+}
+
+// recordJSON encodes infoRecord, with the value masked for secret tags
+// unless -show-secrets is given.
+func (c *cli) recordJSON(infoRecord *airport.InfoRecord) (json.RawMessage, error) {
+	encoded, err := json.Marshal(infoRecord)
+	if nil != err || !c.isSecret(infoRecord.Tag) || c.showSecrets {
+		return encoded, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(encoded, &fields); nil != err {
+		return nil, err
+	}
+	if "null" != string(fields["value"]) {
+		fields["value"], _ = json.Marshal(masked)
+	}
+	delete(fields, "raw")
+
+	return json.Marshal(fields)
+}
+
+func maskValue(value string) string {
+	if "" == value {
+		return ""
+	}
+
+	return masked
+}
+
+// maskHex masks a value returned by hexValue.
+func maskHex(value string) string {
+	if "-" == value {
+		return value
+	}
+
+	return masked
+}
+
+// hexValue returns the rows of a record as hex, separated by commas.
+func hexValue(rows []*airport.InfoRecord) string {
+	if 0 == len(rows) {
+		return "-"
+	}
+
+	values := make([]string, 0, len(rows))
+	for _, infoRecord := range rows {
+		values = append(values, hex.EncodeToString(infoRecord.Value))
+	}
+
+	return strings.Join(values, ",")
+}
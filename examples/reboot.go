@@ -2,21 +2,27 @@ package main
 
 import (
 	"../src"
+	"context"
 	"flag"
 	"fmt"
-	"net"
 	"strings"
+	"time"
 )
 
 func main() {
-	address := flag.String("address", "10.0.0.1", "Airport address.")
+	address := flag.String("address", "10.0.0.1", "Airport address, optionally with port.")
 	password := flag.String("password", "superSecret", "Airport station password.")
 	flag.Parse()
-	station := &airport.Airport{
-		Password: strings.TrimSpace(*password),             // Your password here.
-		Address:  net.ParseIP(strings.TrimSpace(*address)), // Base station IP.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	station, err := airport.New(
+		strings.TrimSpace(*address),                        // Base station host, IP or host:port.
+		airport.WithPassword(strings.TrimSpace(*password)), // Your password here.
+	)
+	if nil != err {
+		panic(err)
 	}
-	err := station.Reboot()
+	err = station.Reboot(ctx)
 	if nil != err {
 		panic(err)
 	}
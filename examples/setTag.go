@@ -0,0 +1,35 @@
+package main
+
+import (
+	"../src"
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func main() {
+	tag := flag.String("prop", "syNm", "Property to set on station.")
+	value := flag.String("value", "", "New property value.")
+	address := flag.String("address", "10.0.0.1", "Airport address, optionally with port.")
+	password := flag.String("password", "superSecret", "Airport station password.")
+
+	flag.Parse()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	station, err := airport.New(
+		strings.TrimSpace(*address),                        // Base station host, IP or host:port.
+		airport.WithPassword(strings.TrimSpace(*password)), // Your password here.
+	)
+	if nil != err {
+		panic(err)
+	}
+
+	err = station.SetProperty(ctx, *tag, *value)
+	if nil != err {
+		panic(err)
+	}
+
+	fmt.Printf("Set %s to %s\n", *tag, *value)
+}
@@ -3,20 +3,28 @@ package main
 import (
 	"../src"
 	"bytes"
+	"context"
 	"flag"
 	"fmt"
-	"io"
-	"math/rand"
-	"net"
+	"os"
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
-var ips = []net.IP{}
+var stations []*airport.Airport
 
-var passwords = []string{}
+// nextStation counts the batches handed out, so they go to the stations in
+// turn.
+var nextStation uint32
+
+// maxAttempts bounds how often a batch of tags is tried before giving up.
+const maxAttempts = 3
+
+// requestTimeout bounds a single request.
+const requestTimeout = 10 * time.Second
 
 var chars = []string{
 	"a",
@@ -104,12 +112,18 @@ func main() {
 	splittedIps := strings.Split(*ipString, ",")
 	splittedpasswords := strings.Split(*passwordString, ",")
 
-	for _, ip := range splittedIps {
-		ips = append(ips, net.ParseIP(strings.TrimSpace(ip)))
+	if len(splittedIps) != len(splittedpasswords) {
+		fmt.Println("Need one password per IP.")
+		os.Exit(2)
 	}
 
-	for _, password := range splittedpasswords {
-		passwords = append(passwords, strings.TrimSpace(password))
+	for index, ip := range splittedIps {
+		station, err := airport.New(strings.TrimSpace(ip), airport.WithPassword(strings.TrimSpace(splittedpasswords[index])))
+		if nil != err {
+			fmt.Println(err)
+			os.Exit(2)
+		}
+		stations = append(stations, station)
 	}
 
 	var mutex = &sync.Mutex{}
@@ -137,11 +151,17 @@ func main() {
 	for w := 1; w <= runtime.GOMAXPROCS(0); w++ {
 		go func() {
 			for entry := range workChan {
-				records, err := checkTags(entry)
+				var records []*airport.InfoRecord
+				var err error
+				for attempt := 1; attempt <= maxAttempts; attempt++ {
+					records, err = checkTags(entry)
+					if nil == err {
+						break
+					}
+					time.Sleep(time.Duration(attempt) * 50 * time.Millisecond)
+				}
 				if nil != err {
-					time.Sleep(50 * time.Millisecond)
-					workChan <- entry
-					return
+					fmt.Printf("Giving up on %s..%s: %v\n", entry[0], entry[len(entry)-1], err)
 				}
 				for _, record := range records {
 					if nil != record {
@@ -172,65 +192,20 @@ func main() {
 	close(strChan)
 }
 
-func read(requestPayload []byte) ([]byte, error) {
-	station := rand.Intn(len(ips))
-	requestMessage := airport.NewMessage(airport.MessageTypeRead, passwords[station], requestPayload, len(requestPayload))
-	conn, err := createConnection(ips[station])
-	if nil != err {
-		return nil, err
-	}
-
-	defer conn.Close()
-
-	_, err = conn.Write(requestMessage.GetBytes())
-	if nil != err {
-		return nil, err
-	}
-
-	_, err = conn.Write(requestPayload)
-	if nil != err {
-		return nil, err
-	}
-
-	responseHeader := make([]byte, 128)
-
-	_, err = conn.Read(responseHeader)
-	if nil != err {
-		return nil, err
-	}
-
-	responseBuffer := new(bytes.Buffer)
-	io.Copy(responseBuffer, conn)
+// checkTags asks a single station for expectedTags. The stations take the
+// batches in turn, so a retry goes to the next one.
+func checkTags(expectedTags []string) ([]*airport.InfoRecord, error) {
+	station := stations[(atomic.AddUint32(&nextStation, 1)-1)%uint32(len(stations))]
 
-	return responseBuffer.Bytes(), nil
-}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
 
-func createConnection(ip net.IP) (*net.TCPConn, error) {
-	address := &net.TCPAddr{
-		IP:   ip,
-		Port: 5009,
-	}
-	conn, err := net.DialTCP("tcp", nil, address)
+	info, err := station.GetProperties(ctx, expectedTags...)
 	if nil != err {
 		return nil, err
 	}
 
-	return conn, nil
-}
-
-func checkTags(expectedTags []string) ([]*airport.InfoRecord, error) {
-	var payload []byte
 	var foundTags []*airport.InfoRecord
-
-	for _, expectedTag := range expectedTags {
-		payload = append(payload, airport.NewInfoRecord(expectedTag, "", airport.TypeByteString, airport.EncryptionUnencrypted, 0, make([]byte, 0)).GetRequestBytes()...)
-	}
-	data, err := read(payload)
-	if nil != err {
-		return []*airport.InfoRecord{}, err
-	}
-	info := airport.NewInfo(data)
-
 	for _, expectedTag := range expectedTags {
 		element := info.Get(expectedTag)
 		if nil != element && (4 != element.MaxLength || 0 != bytes.Compare(element.GetValue(), make([]byte, element.MaxLength))) {
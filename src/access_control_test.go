@@ -0,0 +1,117 @@
+package airport_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+func mustMAC(t *testing.T, s string) net.HardwareAddr {
+	t.Helper()
+
+	mac, err := net.ParseMAC(s)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return mac
+}
+
+func TestAccessControlEntryBinary(t *testing.T) {
+	entry := airport.AccessControlEntry{MAC: mustMAC(t, "00:11:22:33:44:55"), Host: "laptop"}
+
+	data, err := entry.MarshalBinary()
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if 16 != len(data) || "laptop\x00\x00\x00\x00" != string(data[6:]) {
+		t.Errorf("MarshalBinary() = %x", data)
+	}
+
+	var decoded airport.AccessControlEntry
+	if err = decoded.UnmarshalBinary(data); nil != err {
+		t.Fatal(err)
+	}
+
+	if entry.String() != decoded.String() {
+		t.Errorf("UnmarshalBinary() = %v, want %v", decoded, entry)
+	}
+
+	invalid := []airport.AccessControlEntry{
+		{MAC: mustMAC(t, "00:11:22:33:44:55:66:77")},
+		{MAC: entry.MAC, Host: strings.Repeat("x", 11)},
+	}
+	for _, entry := range invalid {
+		if _, err := entry.MarshalBinary(); !errors.Is(err, airport.ErrInvalidValue) {
+			t.Errorf("MarshalBinary(%v) error = %v, want ErrInvalidValue", entry, err)
+		}
+	}
+
+	for _, data := range [][]byte{make([]byte, 5), make([]byte, 17)} {
+		if err := decoded.UnmarshalBinary(data); !errors.Is(err, airport.ErrInvalidValue) {
+			t.Errorf("UnmarshalBinary(%d bytes) error = %v, want ErrInvalidValue", len(data), err)
+		}
+	}
+}
+
+func TestAccessControl(t *testing.T) {
+	ctx := context.Background()
+	server := newServer(t, "secret")
+
+	list := &airport.AccessControlList{Enabled: true}
+	list.Add(mustMAC(t, "00:11:22:33:44:55"), "laptop")
+	list.Add(mustMAC(t, "00:11:22:33:44:66"), "")
+	list.Add(mustMAC(t, "00:11:22:33:44:55"), "desktop")
+
+	if err := server.Airport().SetAccessControl(ctx, list); nil != err {
+		t.Fatal(err)
+	}
+
+	if rows := server.GetAll("acTa"); 2 != len(rows) {
+		t.Fatalf("server holds %d acTa rows, want 2", len(rows))
+	}
+
+	read, err := server.Airport().GetAccessControl(ctx)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if !read.Enabled || 2 != len(read.Entries) || "00:11:22:33:44:55 desktop" != read.Entries[0].String() {
+		t.Errorf("GetAccessControl() = %+v", read)
+	}
+
+	// removing every entry leaves a single empty row
+	read.Remove(mustMAC(t, "00:11:22:33:44:55"))
+	read.Remove(mustMAC(t, "00:11:22:33:44:66"))
+	if read.Remove(mustMAC(t, "00:11:22:33:44:66")) {
+		t.Errorf("Remove() of a missing entry reported true")
+	}
+
+	if err = server.Airport().SetAccessControl(ctx, read); nil != err {
+		t.Fatal(err)
+	}
+
+	if rows := server.GetAll("acTa"); 1 != len(rows) || 0 != len(rows[0].Value) {
+		t.Errorf("server holds %v, want a single empty acTa row", rows)
+	}
+
+	if read, err = server.Airport().GetAccessControl(ctx); nil != err || 0 != len(read.Entries) {
+		t.Errorf("GetAccessControl() = %+v, %v, want no entries", read, err)
+	}
+}
+
+func TestAccessControlDuplicate(t *testing.T) {
+	list := &airport.AccessControlList{Entries: []airport.AccessControlEntry{
+		{MAC: mustMAC(t, "00:11:22:33:44:55")},
+		{MAC: mustMAC(t, "00:11:22:33:44:55")},
+	}}
+
+	if _, err := list.Records(); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("Records() error = %v, want ErrInvalidValue", err)
+	}
+}
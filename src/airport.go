@@ -1,116 +1,504 @@
 package airport
 
 import (
-	"bytes"
+	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 )
 
+// DefaultPort is the TCP port base stations listen on.
+const DefaultPort = 5009
+
+// maxRequestPayloadSize bounds the payload of a single read request.
+// GetProperties splits larger requests.
+const maxRequestPayloadSize = 1024
+
+// maxResponsePayloadSize bounds the payload a station may send back. The size
+// comes from the station, so it is not trusted for allocations.
+const maxResponsePayloadSize = 1 << 20
+
 // Airport TODO
 type Airport struct {
 	Password string
 	Address  net.IP
+	// Port overrides DefaultPort when non-zero.
+	Port int
+	// Timeout bounds every operation whose context carries no deadline of its
+	// own. Zero means no timeout.
+	Timeout time.Duration
+
+	host      string
+	dialer    Dialer
+	localAddr net.IP
+}
+
+// New returns a client for the station at addr, which is a host name or IP
+// address optionally followed by a port, such as "10.0.1.1",
+// "airport.local:5009", "fe80::1" or "[fe80::1]:5009".
+func New(addr string, opts ...Option) (*Airport, error) {
+	a := &Airport{}
+
+	host, port, err := net.SplitHostPort(addr)
+	if nil != err {
+		// no port given
+		host = strings.TrimSuffix(strings.TrimPrefix(addr, "["), "]")
+	} else {
+		a.Port, err = strconv.Atoi(port)
+		if nil != err || 0 >= a.Port || 65535 < a.Port {
+			return nil, fmt.Errorf("airport: invalid port in address %q", addr)
+		}
+	}
+
+	if "" == host {
+		return nil, fmt.Errorf("airport: missing host in address %q", addr)
+	}
+
+	if ip := net.ParseIP(host); nil != ip {
+		a.Address = ip
+	} else {
+		a.host = host
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a, nil
 }
 
-//Reboot TODO
-func (a *Airport) Reboot() error {
-	info := NewInfo(nil).Get("acRB").GetUpdateBytes()
+// Reboot restarts the station. A station may go down without acknowledging
+// the request, so once the request is sent Reboot waits only briefly for an
+// answer and reports success if none comes.
+func (a *Airport) Reboot(ctx context.Context) error {
+	err := a.send(ctx, lookupInfoRecord(TagReboot).GetUpdateBytes(), rebootAckWait)
+	if errors.Is(err, syscall.ECONNRESET) {
+		// the station may drop the connection as it goes down
+		return nil
+	}
+
+	return err
+}
 
-	return a.write(info)
+// GetStationName returns the station name. It is kept for existing callers,
+// see StationName.
+func (a *Airport) GetStationName(ctx context.Context) (string, error) {
+	return a.StationName(ctx)
 }
 
-// GetStationName TODO
-func (a *Airport) GetStationName() (string, error) {
-	tag, err := a.GetProperty("syNm")
+// GetProperty reads a single property. It fails with ErrUnknownTag when the
+// station does not return the tag, and with ErrInvalidRecord when it returns
+// the invalid marker instead of a value.
+func (a *Airport) GetProperty(ctx context.Context, tag string) (*InfoRecord, error) {
+	info, err := a.GetProperties(ctx, tag)
 
 	if nil != err {
-		return "", err
+		return nil, err
+	}
+
+	infoRecord := info.Get(tag)
+	if nil == infoRecord {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTag, tag)
+	}
+
+	if RecordInvalid == info.State(tag) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRecord, tag)
 	}
 
-	return string(tag.GetValue()), nil
+	return infoRecord, nil
 }
 
-// GetProperty TODO
-func (a *Airport) GetProperty(tag string) (*InfoRecord, error) {
-	infoRecord := NewInfo(nil).Get(tag)
+// GetProperties reads several properties at once. Tags are batched into as
+// few requests as possible; the returned Info holds only the requested tags
+// that the station returned, see Info.State.
+func (a *Airport) GetProperties(ctx context.Context, tags ...string) (*Info, error) {
+	result := newInfo()
+	requested := make(map[string]bool)
+	var requestPayload []byte
+	var pending []string
 
-	if nil == infoRecord {
-		// Unknown item, lets construct it ourselves. Properties do not matter, airport will return actual ones.
-		infoRecord = NewInfoRecord(tag, "", TypeByteString, EncryptionUnencrypted, 0, make([]byte, 0))
+	flush := func() error {
+		if 0 == len(pending) {
+			return nil
+		}
+
+		info, err := a.read(ctx, requestPayload)
+		if nil != err {
+			return err
+		}
+
+		for _, tag := range pending {
+			if state := info.State(tag); RecordUnsupported != state {
+				result.put(tag, info.Get(tag), state)
+				for _, repeated := range info.GetAll(tag)[1:] {
+					result.Add(tag, repeated)
+				}
+			}
+		}
+
+		requestPayload = nil
+		pending = nil
+		return nil
 	}
 
-	info, err := a.read(infoRecord.GetRequestBytes())
+	for _, tag := range tags {
+		if requested[tag] {
+			continue
+		}
+		requested[tag] = true
+
+		// Unknown items get a generic record. Properties do not matter, airport will return actual ones.
+		infoRecord := GetInfoRecord(tag)
 
+		requestBytes := infoRecord.GetRequestBytes()
+		if len(requestPayload)+len(requestBytes) > maxRequestPayloadSize {
+			if err := flush(); nil != err {
+				return nil, err
+			}
+		}
+
+		requestPayload = append(requestPayload, requestBytes...)
+		pending = append(pending, tag)
+	}
+
+	if err := flush(); nil != err {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ReadAll reads every registered tag plus any extra tags. Unlike
+// GetProperties the returned Info holds all of them; use Info.State to tell
+// unsupported and invalid records from those with a value.
+func (a *Airport) ReadAll(ctx context.Context, extra ...string) (*Info, error) {
+	allTags := append(RegisteredTags(), extra...)
+
+	info, err := a.GetProperties(ctx, allTags...)
 	if nil != err {
 		return nil, err
 	}
 
-	return info.Get(tag), nil
+	for _, tag := range allTags {
+		if nil != info.Get(tag) {
+			continue
+		}
+
+		info.put(tag, GetInfoRecord(tag), RecordUnsupported)
+	}
+
+	return info, nil
+}
+
+// SetProperty validates value against the registered type of tag and writes
+// it to the station.
+func (a *Airport) SetProperty(ctx context.Context, tag string, value string) error {
+	return a.SetProperties(ctx, map[string]string{tag: value})
 }
 
-func (a *Airport) read(requestPayload []byte) (*Info, error) {
+// SetProperties validates and writes several properties in a single request.
+// Nothing is written if any of the values is invalid.
+func (a *Airport) SetProperties(ctx context.Context, values map[string]string) error {
+	tags := make([]string, 0, len(values))
+	for tag := range values {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	records := make([]*InfoRecord, 0, len(tags))
+	for _, tag := range tags {
+		infoRecord := lookupInfoRecord(tag)
+		if nil == infoRecord {
+			return fmt.Errorf("%w: %s", ErrUnknownTag, tag)
+		}
+
+		if infoRecord.ReadOnly {
+			return fmt.Errorf("%w: %s", ErrReadOnlyTag, tag)
+		}
+
+		value, err := infoRecord.parseString(values[tag])
+		if nil != err {
+			return err
+		}
+
+		infoRecord.SetValue(value)
+		records = append(records, infoRecord)
+	}
+
+	return a.writeRecords(ctx, records...)
+}
+
+// writeRecords writes only the given records to the station.
+func (a *Airport) writeRecords(ctx context.Context, records ...*InfoRecord) error {
+	if 0 == len(records) {
+		return nil
+	}
+
+	var requestPayload []byte
+	for _, infoRecord := range records {
+		requestPayload = append(requestPayload, infoRecord.GetUpdateBytes()...)
+	}
+
+	return a.write(ctx, requestPayload)
+}
+
+func (a *Airport) read(ctx context.Context, requestPayload []byte) (*Info, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+
 	requestMessage := NewMessage(MessageTypeRead, a.Password, requestPayload, len(requestPayload))
-	conn, err := a.createConnection()
+	conn, err := a.createConnection(ctx)
 	if nil != err {
 		return nil, err
 	}
 
 	defer conn.Close()
+	stop := watchConnection(ctx, conn)
+	defer stop()
 
 	_, err = conn.Write(requestMessage.GetBytes())
 	if nil != err {
-		return nil, err
+		return nil, contextError(ctx, err)
 	}
 
 	_, err = conn.Write(requestPayload)
+	if nil != err {
+		return nil, contextError(ctx, err)
+	}
+
+	responsePayload, err := a.readResponse(conn, MessageTypeRead)
+	if nil != err {
+		return nil, contextError(ctx, err)
+	}
+
+	return ParseInfo(responsePayload)
+}
+
+// readResponse reads a response header and its payload from conn and checks
+// both against each other.
+func (a *Airport) readResponse(conn net.Conn, messageType int) ([]byte, error) {
+	responseHeader := make([]byte, MessageSize)
+
+	n, err := io.ReadFull(conn, responseHeader)
+	if errors.Is(err, io.ErrUnexpectedEOF) || (errors.Is(err, io.EOF) && 0 < n) {
+		return nil, fmt.Errorf("%w: response header is %d bytes, expected %d", ErrTruncatedResponse, n, MessageSize)
+	}
 	if nil != err {
 		return nil, err
 	}
 
-	responseHeader := make([]byte, 128)
+	responseMessage, err := ParseMessage(responseHeader)
+	if nil != err {
+		return nil, err
+	}
+
+	if messageType != responseMessage.MessageType() {
+		return nil, fmt.Errorf("%w: response message type is %#x, expected %#x", ErrInvalidMessage, responseMessage.MessageType(), messageType)
+	}
+
+	if 0 != responseMessage.Status() {
+		return nil, &StationError{Code: responseMessage.Status()}
+	}
 
-	_, err = conn.Read(responseHeader)
+	if maxResponsePayloadSize < responseMessage.PayloadSize() {
+		return nil, fmt.Errorf("%w: response payload is %d bytes, maximum %d", ErrInvalidMessage, responseMessage.PayloadSize(), maxResponsePayloadSize)
+	}
+
+	var responsePayload []byte
+	if 0 <= responseMessage.PayloadSize() {
+		responsePayload = make([]byte, responseMessage.PayloadSize())
+		n, err = io.ReadFull(conn, responsePayload)
+		if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: response payload is %d bytes, expected %d", ErrTruncatedResponse, n, responseMessage.PayloadSize())
+		}
+	} else {
+		// without an announced size the payload ends with the connection
+		responsePayload, err = io.ReadAll(io.LimitReader(conn, maxResponsePayloadSize+1))
+		if nil == err && maxResponsePayloadSize < len(responsePayload) {
+			return nil, fmt.Errorf("%w: response payload exceeds %d bytes", ErrInvalidMessage, maxResponsePayloadSize)
+		}
+	}
+	if nil != err {
+		return nil, err
+	}
+
+	err = responseMessage.VerifyPayload(responsePayload)
 	if nil != err {
 		return nil, err
 	}
 
-	responseBuffer := new(bytes.Buffer)
-	io.Copy(responseBuffer, conn)
+	return responsePayload, nil
+}
+
+// rebootAckWait bounds how long Reboot waits for the station to acknowledge,
+// a station that is going down may never answer.
+const rebootAckWait = 2 * time.Second
 
-	return NewInfo(responseBuffer.Bytes()), nil
+func (a *Airport) write(ctx context.Context, requestPayload []byte) error {
+	return a.send(ctx, requestPayload, 0)
 }
 
-func (a *Airport) write(requestPayload []byte) error {
+// send writes requestPayload and waits for the station to acknowledge it.
+// With a non-zero ackWait it waits at most that long, and a station that
+// stays quiet or drops the connection after the whole request went out
+// counts as having accepted it.
+func (a *Airport) send(ctx context.Context, requestPayload []byte, ackWait time.Duration) error {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+
 	requestMessage := NewMessage(MessageTypeWrite, a.Password, requestPayload, len(requestPayload))
-	conn, err := a.createConnection()
+	conn, err := a.createConnection(ctx)
 	if nil != err {
 		return err
 	}
 
 	defer conn.Close()
+	stop := watchConnection(ctx, conn)
+	defer stop()
 
 	_, err = conn.Write(requestMessage.GetBytes())
 	if nil != err {
-		return err
+		return contextError(ctx, err)
 	}
 
 	_, err = conn.Write(requestPayload)
 	if nil != err {
-		return err
+		return contextError(ctx, err)
+	}
+
+	// Write payloads have no announced size, signal the end of it by closing
+	// our side of the connection.
+	err = closeWrite(conn)
+	if nil != err {
+		return contextError(ctx, err)
+	}
+
+	if 0 < ackWait {
+		deadline := time.Now().Add(ackWait)
+		if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+			deadline = ctxDeadline
+		}
+		err = conn.SetReadDeadline(deadline)
+		if nil != err {
+			return contextError(ctx, err)
+		}
+	}
+
+	// Stations that do not acknowledge writes just close the connection.
+	_, err = a.readResponse(conn, MessageTypeWrite)
+	switch {
+	case nil == err, errors.Is(err, io.EOF):
+		return nil
+	case 0 < ackWait && !errors.Is(ctx.Err(), context.Canceled) &&
+		(errors.Is(err, os.ErrDeadlineExceeded) || errors.Is(err, syscall.ECONNRESET)):
+		return nil
 	}
 
-	return nil
+	return contextError(ctx, err)
+}
+
+// closeWrite shuts down the sending side of conn, unwrapping connections
+// that expose the one they wrap with NetConn, as *tls.Conn does. Without a
+// half-close the station keeps waiting for the write payload and never
+// answers, so that is an error.
+func closeWrite(conn net.Conn) error {
+	for {
+		if closer, ok := conn.(interface{ CloseWrite() error }); ok {
+			return closer.CloseWrite()
+		}
+
+		wrapper, ok := conn.(interface{ NetConn() net.Conn })
+		if !ok {
+			return fmt.Errorf("%w: %T", ErrHalfCloseUnsupported, conn)
+		}
+		conn = wrapper.NetConn()
+	}
 }
 
-func (a *Airport) createConnection() (*net.TCPConn, error) {
-	address := &net.TCPAddr{
-		IP:   a.Address,
-		Port: 5009,
+func (a *Airport) createConnection(ctx context.Context) (net.Conn, error) {
+	if nil != ctx.Err() {
+		return nil, ctx.Err()
+	}
+
+	var dialer Dialer = a.dialer
+	if nil == dialer {
+		netDialer := &net.Dialer{}
+		if nil != a.localAddr {
+			netDialer.LocalAddr = &net.TCPAddr{IP: a.localAddr}
+		}
+		dialer = netDialer
 	}
-	conn, err := net.DialTCP("tcp", nil, address)
+
+	conn, err := dialer.DialContext(ctx, "tcp", a.address())
 	if nil != err {
-		return nil, err
+		return nil, contextError(ctx, err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		err = conn.SetDeadline(deadline)
+		if nil != err {
+			conn.Close()
+			return nil, err
+		}
 	}
 
 	return conn, nil
 }
+
+// address returns the host:port to dial.
+func (a *Airport) address() string {
+	host := a.host
+	if "" == host {
+		host = a.Address.String()
+	}
+
+	port := DefaultPort
+	if 0 != a.Port {
+		port = a.Port
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+// withTimeout applies the station timeout to ctx unless it already has a
+// deadline.
+func (a *Airport) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || 0 >= a.Timeout {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, a.Timeout)
+}
+
+// watchConnection unblocks any pending I/O on conn once ctx is done. The
+// returned function stops watching.
+func watchConnection(ctx context.Context, conn net.Conn) func() bool {
+	return context.AfterFunc(ctx, func() {
+		conn.SetDeadline(time.Unix(1, 0))
+	})
+}
+
+// contextError prefers the context's error over err, so that a cancelled or
+// expired operation reports why it was interrupted rather than a bare I/O
+// timeout.
+func contextError(ctx context.Context, err error) error {
+	if nil != ctx.Err() {
+		return ctx.Err()
+	}
+
+	// The connection deadline is the context deadline, so the socket usually
+	// times out just before the context notices.
+	deadline, ok := ctx.Deadline()
+	if ok && errors.Is(err, os.ErrDeadlineExceeded) && !time.Now().Before(deadline) {
+		return fmt.Errorf("%w: %w", context.DeadlineExceeded, err)
+	}
+
+	return err
+}
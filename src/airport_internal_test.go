@@ -0,0 +1,39 @@
+package airport
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+)
+
+// expiredContext has a deadline in the past whose timer has not fired yet,
+// like a context racing a connection deadline.
+type expiredContext struct {
+	context.Context
+}
+
+func (expiredContext) Deadline() (time.Time, bool) {
+	return time.Now().Add(-time.Millisecond), true
+}
+
+func TestContextErrorBeforeTimer(t *testing.T) {
+	ioErr := &net.OpError{Op: "read", Net: "tcp", Err: os.ErrDeadlineExceeded}
+
+	err := contextError(expiredContext{context.Background()}, ioErr)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("contextError() = %v, want context.DeadlineExceeded", err)
+	}
+
+	if !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Errorf("contextError() = %v, should wrap the I/O error", err)
+	}
+
+	// without a deadline I/O errors are passed through
+	err = contextError(context.Background(), ioErr)
+	if ioErr != err {
+		t.Errorf("contextError() = %v, want %v", err, ioErr)
+	}
+}
@@ -0,0 +1,534 @@
+package airport_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	airport "github.com/jutaz/go-airport/src"
+	"github.com/jutaz/go-airport/src/airporttest"
+)
+
+// newServer starts a mock station that is closed when the test ends.
+func newServer(t *testing.T, password string) *airporttest.Server {
+	t.Helper()
+
+	server, err := airporttest.NewServer(password, nil)
+	if nil != err {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { server.Close() })
+
+	return server
+}
+
+// newRecord returns a registered record holding value in its text form.
+func newRecord(t *testing.T, tag string, value string) *airport.InfoRecord {
+	t.Helper()
+
+	infoRecord := airport.GetInfoRecord(tag)
+	if err := infoRecord.SetBytesFromString(value); nil != err {
+		t.Fatal(err)
+	}
+
+	return infoRecord
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		addr    string
+		address string
+		port    int
+	}{
+		{"10.0.1.1", "10.0.1.1", 0},
+		{"10.0.1.1:5010", "10.0.1.1", 5010},
+		{"fe80::1", "fe80::1", 0},
+		{"[fe80::1]:5010", "fe80::1", 5010},
+		{"airport.local", "<nil>", 0},
+	}
+
+	for _, test := range tests {
+		a, err := airport.New(test.addr)
+		if nil != err {
+			t.Errorf("New(%q): %v", test.addr, err)
+			continue
+		}
+
+		if test.address != a.Address.String() || test.port != a.Port {
+			t.Errorf("New(%q) = %s port %d, want %s port %d", test.addr, a.Address, a.Port, test.address, test.port)
+		}
+	}
+
+	for _, addr := range []string{"", ":5009", "10.0.1.1:0", "10.0.1.1:70000", "10.0.1.1:x"} {
+		if _, err := airport.New(addr); nil == err {
+			t.Errorf("New(%q) succeeded", addr)
+		}
+	}
+}
+
+func TestGetStationName(t *testing.T) {
+	server := newServer(t, "secret")
+	server.Put(newRecord(t, "syNm", "Office"))
+
+	name, err := server.Airport().GetStationName(context.Background())
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if "Office" != name {
+		t.Errorf("GetStationName() = %q, want %q", name, "Office")
+	}
+}
+
+func TestGetPropertyInvalid(t *testing.T) {
+	info, err := airport.ParseInfo(rawRecord("syNm", 2, airport.EncryptBytes(airport.CipherBytes, []byte{0xFF, 0xFF, 0xFF, 0xF6})))
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	server, err := airporttest.NewServer("secret", info)
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	if _, err = server.Airport().GetProperty(context.Background(), "syNm"); !errors.Is(err, airport.ErrInvalidRecord) {
+		t.Errorf("GetProperty() error = %v, want ErrInvalidRecord", err)
+	}
+
+	name, err := server.Airport().StationName(context.Background())
+	if !errors.Is(err, airport.ErrInvalidRecord) {
+		t.Errorf("StationName() = %q, %v, want ErrInvalidRecord", name, err)
+	}
+
+	if _, err = server.Airport().GetProperty(context.Background(), "syLo"); !errors.Is(err, airport.ErrUnknownTag) {
+		t.Errorf("GetProperty() of a missing tag error = %v, want ErrUnknownTag", err)
+	}
+}
+
+func TestAuthenticationFailed(t *testing.T) {
+	server := newServer(t, "secret")
+
+	a := server.Airport()
+	a.Password = "wrong"
+
+	_, err := a.GetProperty(context.Background(), "syNm")
+	if !errors.Is(err, airport.ErrAuthenticationFailed) {
+		t.Errorf("GetProperty() error = %v, want ErrAuthenticationFailed", err)
+	}
+}
+
+// dialerFunc adapts a function to airport.Dialer.
+type dialerFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+func (f dialerFunc) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	return f(ctx, network, address)
+}
+
+// wrappedConn hides the CloseWrite method of the connection it wraps.
+type wrappedConn struct {
+	net.Conn
+}
+
+// unwrappableConn hides CloseWrite but hands out the wrapped connection.
+type unwrappableConn struct {
+	net.Conn
+}
+
+func (c unwrappableConn) NetConn() net.Conn {
+	return c.Conn
+}
+
+func TestDialerHalfClose(t *testing.T) {
+	tests := []struct {
+		name string
+		wrap func(net.Conn) net.Conn
+		err  error
+	}{
+		{"tcp", func(conn net.Conn) net.Conn { return conn }, airport.ErrAuthenticationFailed},
+		{"NetConn", func(conn net.Conn) net.Conn { return unwrappableConn{conn} }, airport.ErrAuthenticationFailed},
+		{"no CloseWrite", func(conn net.Conn) net.Conn { return wrappedConn{conn} }, airport.ErrHalfCloseUnsupported},
+	}
+
+	server := newServer(t, "secret")
+	for _, test := range tests {
+		dialer := dialerFunc(func(ctx context.Context, network, address string) (net.Conn, error) {
+			var d net.Dialer
+			conn, err := d.DialContext(ctx, network, address)
+			if nil != err {
+				return nil, err
+			}
+			return test.wrap(conn), nil
+		})
+
+		a, err := airport.New(server.Addr().String(), airport.WithPassword("wrong"), airport.WithDialer(dialer), airport.WithTimeout(time.Second))
+		if nil != err {
+			t.Fatal(err)
+		}
+
+		// a write never reports success without the station's answer
+		if err := a.SetProperty(context.Background(), "syNm", "Office"); !errors.Is(err, test.err) {
+			t.Errorf("%s: SetProperty() error = %v, want %v", test.name, err, test.err)
+		}
+	}
+}
+
+func TestContextDeadline(t *testing.T) {
+	// a station that accepts connections but never answers
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if nil != err {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	a, err := airport.New(listener.Addr().String())
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 5; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		_, err = a.GetProperty(ctx, "syNm")
+		cancel()
+
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("GetProperty() error = %v, want context.DeadlineExceeded", err)
+		}
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	a, err := airport.New(listener.Addr().String(), airport.WithTimeout(100*time.Millisecond))
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	_, err = a.GetProperty(context.Background(), "syNm")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GetProperty() error = %v, want context.DeadlineExceeded", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("GetProperty() took %s", elapsed)
+	}
+}
+
+func TestContextCanceled(t *testing.T) {
+	server := newServer(t, "secret")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := server.Airport().GetProperty(ctx, "syNm")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("GetProperty() error = %v, want context.Canceled", err)
+	}
+}
+
+// quietStation starts a listener that reads each request and never answers,
+// like a station going down for a reboot.
+func quietStation(t *testing.T, opts ...airport.Option) *airport.Airport {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if nil != err {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	t.Cleanup(func() {
+		close(done)
+		listener.Close()
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if nil != err {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(io.Discard, conn)
+				<-done
+			}()
+		}
+	}()
+
+	a, err := airport.New(listener.Addr().String(), opts...)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return a
+}
+
+func TestReboot(t *testing.T) {
+	server := newServer(t, "secret")
+	if err := server.Airport().Reboot(context.Background()); nil != err {
+		t.Errorf("Reboot() error = %v", err)
+	}
+
+	a := server.Airport()
+	a.Password = "wrong"
+	if err := a.Reboot(context.Background()); !errors.Is(err, airport.ErrAuthenticationFailed) {
+		t.Errorf("Reboot() with a wrong password error = %v, want ErrAuthenticationFailed", err)
+	}
+}
+
+func TestRebootQuietStation(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []airport.Option
+	}{
+		{"timeout", []airport.Option{airport.WithTimeout(500 * time.Millisecond)}},
+		{"no timeout", nil},
+	}
+
+	for _, test := range tests {
+		a := quietStation(t, test.opts...)
+
+		start := time.Now()
+		if err := a.Reboot(context.Background()); nil != err {
+			t.Errorf("%s: Reboot() error = %v", test.name, err)
+		}
+		if elapsed := time.Since(start); 5*time.Second < elapsed {
+			t.Errorf("%s: Reboot() took %v", test.name, elapsed)
+		}
+	}
+
+	// cancelling is still an error
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	if err := quietStation(t).Reboot(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Reboot() after cancel error = %v, want context.Canceled", err)
+	}
+}
+
+// serveOnce starts a listener that answers the first request with response
+// and closes the connection.
+func serveOnce(t *testing.T, response ...[]byte) *airport.Airport {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if nil != err {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		conn, err := listener.Accept()
+		if nil != err {
+			return
+		}
+		defer conn.Close()
+
+		// read the whole request, closing with unread data resets the
+		// connection
+		header := make([]byte, airport.MessageSize)
+		if _, err := io.ReadFull(conn, header); nil != err {
+			return
+		}
+		request, err := airport.ParseMessage(header)
+		if nil != err {
+			return
+		}
+		if _, err := io.ReadFull(conn, make([]byte, request.PayloadSize())); nil != err {
+			return
+		}
+
+		for _, b := range response {
+			if _, err := conn.Write(b); nil != err {
+				return
+			}
+		}
+	}()
+
+	a, err := airport.New(listener.Addr().String())
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return a
+}
+
+func TestOversizedResponse(t *testing.T) {
+	header := airport.NewMessage(airport.MessageTypeRead, "", nil, 1<<30).GetBytes()
+	a := serveOnce(t, header)
+
+	_, err := a.GetProperty(context.Background(), "syNm")
+	if !errors.Is(err, airport.ErrInvalidMessage) {
+		t.Errorf("GetProperty() error = %v, want ErrInvalidMessage", err)
+	}
+}
+
+func TestUnboundedResponse(t *testing.T) {
+	header := airport.NewMessage(airport.MessageTypeRead, "", nil, -1).GetBytes()
+	a := serveOnce(t, header, make([]byte, 2<<20))
+
+	_, err := a.GetProperty(context.Background(), "syNm")
+	if !errors.Is(err, airport.ErrInvalidMessage) {
+		t.Errorf("GetProperty() error = %v, want ErrInvalidMessage", err)
+	}
+}
+
+func TestTruncatedResponse(t *testing.T) {
+	header := airport.NewMessage(airport.MessageTypeRead, "", nil, 64).GetBytes()
+	a := serveOnce(t, header, make([]byte, 10))
+
+	_, err := a.GetProperty(context.Background(), "syNm")
+	if !errors.Is(err, airport.ErrTruncatedResponse) {
+		t.Errorf("GetProperty() error = %v, want ErrTruncatedResponse", err)
+	}
+
+	a = serveOnce(t, header[:20])
+	_, err = a.GetProperty(context.Background(), "syNm")
+	if !errors.Is(err, airport.ErrTruncatedResponse) {
+		t.Errorf("GetProperty() error = %v, want ErrTruncatedResponse", err)
+	}
+}
+
+// countingProxy forwards connections to server and counts them, one
+// connection per request.
+func countingProxy(t *testing.T, server *airporttest.Server) (*airport.Airport, *int32) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if nil != err {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	var connections int32
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if nil != err {
+				return
+			}
+			atomic.AddInt32(&connections, 1)
+
+			upstream, err := net.Dial("tcp", server.Addr().String())
+			if nil != err {
+				conn.Close()
+				continue
+			}
+
+			go func() {
+				defer conn.Close()
+				io.Copy(upstream, conn)
+			}()
+			go func() {
+				defer upstream.Close()
+				io.Copy(conn, upstream)
+			}()
+		}
+	}()
+
+	a, err := airport.New(listener.Addr().String(), airport.WithPassword(server.Password))
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return a, &connections
+}
+
+func TestGetPropertiesBatching(t *testing.T) {
+	server := newServer(t, "secret")
+
+	// 12 bytes per requested tag, so 200 tags take three requests
+	var tags []string
+	for i := 0; i < 200; i++ {
+		tag := fmt.Sprintf("x%03d", i)
+		tags = append(tags, tag)
+		server.Put(airport.NewInfoRecord(tag, "", airport.TypeCharString, airport.EncryptionUnencrypted, 32, []byte(tag)))
+	}
+
+	a, connections := countingProxy(t, server)
+	info, err := a.GetProperties(context.Background(), append(tags, tags[0], "none")...)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	for _, tag := range tags {
+		if infoRecord := info.Get(tag); nil == infoRecord || tag != string(infoRecord.Value) {
+			t.Fatalf("%s = %v, want %q", tag, infoRecord, tag)
+		}
+	}
+
+	if airport.RecordUnsupported != info.State("none") {
+		t.Errorf("unreturned tag state = %v, want unsupported", info.State("none"))
+	}
+
+	if got := atomic.LoadInt32(connections); 3 != got {
+		t.Errorf("GetProperties() sent %d requests, want 3", got)
+	}
+}
+
+func TestSetProperties(t *testing.T) {
+	ctx := context.Background()
+	server := newServer(t, "secret")
+
+	err := server.Airport().SetProperties(ctx, map[string]string{"syNm": "Office", "laIP": "10.0.1.1", "raCh": "6"})
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	want := map[string][]byte{"syNm": []byte("Office"), "laIP": {10, 0, 1, 1}, "raCh": {0, 0, 0, 6}}
+	for tag, value := range want {
+		if got := server.Get(tag); nil == got || !bytes.Equal(value, got.Value) {
+			t.Errorf("%s = %v, want %x", tag, got, value)
+		}
+	}
+}
+
+func TestSetPropertiesValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]string
+		err    error
+	}{
+		{"unknown tag", map[string]string{"syNm": "Office", "zzzz": "1"}, airport.ErrUnknownTag},
+		{"read-only tag", map[string]string{"syNm": "Office", "buil": "7.6.8"}, airport.ErrReadOnlyTag},
+		{"invalid address", map[string]string{"syNm": "Office", "laIP": "10.0.1"}, airport.ErrInvalidValue},
+		{"invalid integer", map[string]string{"syNm": "Office", "raCh": "-1"}, airport.ErrInvalidValue},
+		{"too long", map[string]string{"syNm": strings.Repeat("x", 64)}, airport.ErrInvalidValue},
+	}
+
+	for _, test := range tests {
+		server := newServer(t, "secret")
+		a, connections := countingProxy(t, server)
+
+		if err := a.SetProperties(context.Background(), test.values); !errors.Is(err, test.err) {
+			t.Errorf("%s: SetProperties() error = %v, want %v", test.name, err, test.err)
+		}
+
+		// nothing is written when any value is invalid
+		if got := atomic.LoadInt32(connections); 0 != got {
+			t.Errorf("%s: SetProperties() sent %d requests", test.name, got)
+		}
+	}
+}
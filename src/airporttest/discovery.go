@@ -0,0 +1,73 @@
+package airporttest
+
+import (
+	"net"
+	"sync"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+// DiscoveryResponder answers discovery requests like a base station, on a
+// local UDP port. Point airport.Discover at it with
+// airport.WithDiscoveryTarget.
+type DiscoveryResponder struct {
+	conn   *net.UDPConn
+	answer []byte
+	wg     sync.WaitGroup
+}
+
+// NewDiscoveryResponder starts a responder on a random loopback port that
+// answers every request with station.
+func NewDiscoveryResponder(station airport.StationInfo) (*DiscoveryResponder, error) {
+	answer, err := station.MarshalBinary()
+	if nil != err {
+		return nil, err
+	}
+
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if nil != err {
+		return nil, err
+	}
+
+	r := &DiscoveryResponder{
+		conn:   conn,
+		answer: answer,
+	}
+
+	r.wg.Add(1)
+	go r.serve()
+
+	return r, nil
+}
+
+// Addr returns the address the responder listens on.
+func (r *DiscoveryResponder) Addr() *net.UDPAddr {
+	return r.conn.LocalAddr().(*net.UDPAddr)
+}
+
+// Close stops the responder.
+func (r *DiscoveryResponder) Close() error {
+	err := r.conn.Close()
+	r.wg.Wait()
+
+	return err
+}
+
+func (r *DiscoveryResponder) serve() {
+	defer r.wg.Done()
+
+	request := make([]byte, 512)
+	for {
+		n, from, err := r.conn.ReadFromUDP(request)
+		if nil != err {
+			return
+		}
+
+		var info airport.StationInfo
+		if nil != info.UnmarshalBinary(request[:n]) {
+			continue
+		}
+
+		r.conn.WriteToUDP(r.answer, from)
+	}
+}
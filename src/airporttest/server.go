@@ -0,0 +1,217 @@
+// Package airporttest provides an in-process base station speaking the ACP
+// protocol, for testing code built on package airport without hardware.
+package airporttest
+
+import (
+	"io"
+	"net"
+	"sync"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+// Server is a fake base station listening on a local TCP port. It answers
+// read requests from its Info and applies write requests to it.
+type Server struct {
+	Password string
+
+	listener net.Listener
+	mutex    sync.Mutex
+	info     *airport.Info
+	wg       sync.WaitGroup
+}
+
+// NewServer starts a server on a random loopback port. A nil info starts it
+// without any records.
+func NewServer(password string, info *airport.Info) (*Server, error) {
+	if nil == info {
+		info, _ = airport.NewInfo(nil)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if nil != err {
+		return nil, err
+	}
+
+	s := &Server{
+		Password: password,
+		listener: listener,
+		info:     info,
+	}
+
+	s.wg.Add(1)
+	go s.serve()
+
+	return s, nil
+}
+
+// Addr returns the address the server listens on.
+func (s *Server) Addr() *net.TCPAddr {
+	return s.listener.Addr().(*net.TCPAddr)
+}
+
+// Airport returns a client configured to talk to the server.
+func (s *Server) Airport() *airport.Airport {
+	a, _ := airport.New(s.Addr().String(), airport.WithPassword(s.Password))
+
+	return a
+}
+
+// Get returns the first record the server currently holds for tag.
+func (s *Server) Get(tag string) *airport.InfoRecord {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if airport.RecordUnsupported == s.info.State(tag) {
+		return nil
+	}
+
+	return s.info.Get(tag)
+}
+
+// Put stores a record the server will answer read requests with, replacing
+// all records for its tag.
+func (s *Server) Put(record *airport.InfoRecord) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.info.Put(record.Tag, record)
+}
+
+// Add stores another record for a tag that occurs more than once, such as
+// a table row.
+func (s *Server) Add(record *airport.InfoRecord) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.info.Add(record.Tag, record)
+}
+
+// GetAll returns all records the server currently holds for tag.
+func (s *Server) GetAll(tag string) []*airport.InfoRecord {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.info.GetAll(tag)
+}
+
+// Close stops the server and waits for pending requests to finish.
+func (s *Server) Close() error {
+	err := s.listener.Close()
+	s.wg.Wait()
+
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if nil != err {
+			return
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	header := make([]byte, airport.MessageSize)
+	if _, err := io.ReadFull(conn, header); nil != err {
+		return
+	}
+
+	request, err := airport.ParseMessage(header)
+	if nil != err {
+		return
+	}
+
+	var payload []byte
+	if 0 <= request.PayloadSize() {
+		payload = make([]byte, request.PayloadSize())
+		_, err = io.ReadFull(conn, payload)
+	} else {
+		payload, err = io.ReadAll(conn)
+	}
+	if nil != err || nil != request.VerifyPayload(payload) {
+		return
+	}
+
+	if request.Password() != s.Password {
+		response := airport.NewMessage(request.MessageType(), "", nil, 0)
+		response.SetStatus(airport.StatusAuthenticationFailed)
+		conn.Write(response.GetBytes())
+		return
+	}
+
+	switch request.MessageType() {
+	case airport.MessageTypeRead:
+		responsePayload := s.read(payload)
+		response := airport.NewMessage(airport.MessageTypeRead, "", responsePayload, len(responsePayload))
+		conn.Write(response.GetBytes())
+		conn.Write(responsePayload)
+	case airport.MessageTypeWrite:
+		s.write(payload)
+		response := airport.NewMessage(airport.MessageTypeWrite, "", nil, 0)
+		conn.Write(response.GetBytes())
+	}
+}
+
+// read answers a read request with the records the server holds, leaving out
+// the ones it does not.
+func (s *Server) read(payload []byte) []byte {
+	requested, err := airport.NewInfo(payload)
+	if nil != err {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var responsePayload []byte
+	for _, tag := range requested.Tags() {
+		if airport.RecordUnsupported == requested.State(tag) {
+			continue
+		}
+
+		switch s.info.State(tag) {
+		case airport.RecordPresent:
+			for _, record := range s.info.GetAll(tag) {
+				responsePayload = append(responsePayload, record.GetUpdateBytes()...)
+			}
+		case airport.RecordInvalid:
+			record := *s.info.Get(tag)
+			record.Value = []byte{0xFF, 0xFF, 0xFF, 0xF6}
+			responsePayload = append(responsePayload, record.GetUpdateBytes()...)
+		}
+	}
+
+	return responsePayload
+}
+
+// write applies the records of a write request.
+func (s *Server) write(payload []byte) {
+	written, _ := airport.NewInfo(payload)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, tag := range written.Tags() {
+		records := written.GetAll(tag)
+		if 0 == len(records) {
+			continue
+		}
+
+		s.info.Put(tag, records[0])
+		for _, record := range records[1:] {
+			s.info.Add(tag, record)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package airporttest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	airport "github.com/jutaz/go-airport/src"
+	"github.com/jutaz/go-airport/src/airporttest"
+)
+
+func TestServer(t *testing.T) {
+	info, err := airport.NewInfo(nil)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	name := airport.GetInfoRecord("syNm")
+	name.SetBytesFromString("Office")
+	info.Put("syNm", name)
+
+	server, err := airporttest.NewServer("secret", info)
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	ctx := context.Background()
+	a := server.Airport()
+
+	got, err := a.GetProperty(ctx, "syNm")
+	if nil != err || "Office" != got.String() {
+		t.Fatalf("GetProperty() = %v, %v, want Office", got, err)
+	}
+
+	if err = a.SetProperty(ctx, "syLo", "Attic"); nil != err {
+		t.Fatal(err)
+	}
+	if got := server.Get("syLo"); nil == got || "Attic" != got.String() {
+		t.Errorf("Get(syLo) = %v, want Attic", got)
+	}
+
+	if nil != server.Get("syCt") {
+		t.Errorf("Get() of a record the server does not hold is not nil")
+	}
+
+	a.Password = "wrong"
+	if _, err = a.GetProperty(ctx, "syNm"); !errors.Is(err, airport.ErrAuthenticationFailed) {
+		t.Errorf("GetProperty() with the wrong password error = %v, want ErrAuthenticationFailed", err)
+	}
+}
+
+func TestServerTable(t *testing.T) {
+	server, err := airporttest.NewServer("", nil)
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	for _, value := range []string{"01", "02", "03"} {
+		row := airport.GetInfoRecord("pmTa")
+		row.SetBytesFromString(value)
+		server.Add(row)
+	}
+
+	info, err := server.Airport().GetProperties(context.Background(), "pmTa")
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	rows := info.GetAll("pmTa")
+	if 3 != len(rows) || "03" != rows[2].String() {
+		t.Errorf("GetProperties() returned rows %v, want 01 02 03", rows)
+	}
+
+	row := airport.GetInfoRecord("pmTa")
+	row.SetBytesFromString("04")
+	server.Put(row)
+	if rows := server.GetAll("pmTa"); 1 != len(rows) {
+		t.Errorf("Put() kept %d rows, want 1", len(rows))
+	}
+}
@@ -0,0 +1,173 @@
+package airport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// BackupVersion is the version of the backup file format written by Backup.
+// Version 2 added a record per table row; version 1 files are still read.
+const BackupVersion = 2
+
+// BackupFile is the JSON document written by Backup and read by Restore.
+type BackupFile struct {
+	Version int            `json:"version"`
+	Build   string         `json:"build"`
+	Records []BackupRecord `json:"records"`
+}
+
+// BackupRecord is a single record of a backup, with its value decoded
+// according to its data type. Values that do not decode are kept as raw hex
+// and marked Raw. Tables such as acTa have a record per row, in order.
+type BackupRecord struct {
+	Tag       string `json:"tag"`
+	DataType  string `json:"dataType"`
+	Encrypted bool   `json:"encrypted"`
+	Value     string `json:"value"`
+	Raw       bool   `json:"raw,omitempty"`
+}
+
+// ReadBackupFile decodes a backup written by Backup and checks its version.
+func ReadBackupFile(r io.Reader) (*BackupFile, error) {
+	backup := &BackupFile{}
+
+	err := json.NewDecoder(r).Decode(backup)
+	if nil != err {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBackup, err)
+	}
+
+	if 1 > backup.Version || BackupVersion < backup.Version {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidBackup, backup.Version)
+	}
+
+	return backup, nil
+}
+
+// Info converts the backup into an Info, for instance to Diff it against the
+// current state of a station.
+func (b *BackupFile) Info() (*Info, error) {
+	info := newInfo()
+
+	for _, backupRecord := range b.Records {
+		infoRecord, err := backupRecord.infoRecord()
+		if nil != err {
+			return nil, err
+		}
+
+		info.Add(backupRecord.Tag, infoRecord)
+	}
+
+	return info, nil
+}
+
+// infoRecord returns the registered record for the backup record, holding
+// its value. The data type and encryption must match the registry.
+func (r BackupRecord) infoRecord() (*InfoRecord, error) {
+	infoRecord := lookupInfoRecord(r.Tag)
+	if nil == infoRecord {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTag, r.Tag)
+	}
+
+	if infoRecord.DataType.String() != r.DataType {
+		return nil, fmt.Errorf("%w: %s is %s in the backup, expected %s", ErrInvalidBackup, r.Tag, r.DataType, infoRecord.DataType)
+	}
+
+	if (EncryptionEncrypted == infoRecord.Encryption) != r.Encrypted {
+		return nil, fmt.Errorf("%w: %s is %s in the backup, expected %s", ErrInvalidBackup, r.Tag, encryptionOf(r.Encrypted), infoRecord.Encryption)
+	}
+
+	value, err := infoRecord.parseText(r.Value, r.Raw)
+	if nil != err {
+		return nil, err
+	}
+
+	infoRecord.SetValue(value)
+	return infoRecord, nil
+}
+
+func encryptionOf(encrypted bool) RecordEncryption {
+	if encrypted {
+		return EncryptionEncrypted
+	}
+
+	return EncryptionUnencrypted
+}
+
+// Backup reads every registered tag and writes the records the station
+// returned a value for to w.
+func (a *Airport) Backup(ctx context.Context, w io.Writer) error {
+	info, err := a.ReadAll(ctx)
+	if nil != err {
+		return err
+	}
+
+	backup := &BackupFile{
+		Version: BackupVersion,
+		Records: make([]BackupRecord, 0),
+	}
+
+	if RecordPresent == info.State(TagBuildHash) {
+		backup.Build = info.Get(TagBuildHash).String()
+	}
+
+	for _, tag := range info.Tags() {
+		if RecordPresent != info.State(tag) {
+			continue
+		}
+
+		for _, infoRecord := range info.GetAll(tag) {
+			value, raw := infoRecord.text()
+			backup.Records = append(backup.Records, BackupRecord{
+				Tag:       tag,
+				DataType:  infoRecord.DataType.String(),
+				Encrypted: EncryptionEncrypted == infoRecord.Encryption,
+				Value:     value,
+				Raw:       raw,
+			})
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(backup)
+}
+
+// Restore writes a backup made by Backup back to the station. A station that
+// reports its build must run the build the backup was taken from. Read-only
+// tags are skipped, tables are written with all their rows.
+func (a *Airport) Restore(ctx context.Context, r io.Reader) error {
+	backup, err := ReadBackupFile(r)
+	if nil != err {
+		return err
+	}
+
+	info, err := a.GetProperties(ctx, TagBuildHash)
+	if nil != err {
+		return err
+	}
+
+	if RecordPresent == info.State(TagBuildHash) {
+		if build := info.Get(TagBuildHash).String(); build != backup.Build {
+			return fmt.Errorf("%w: backup is from build %q, station runs %q", ErrBuildMismatch, backup.Build, build)
+		}
+	}
+
+	records := make([]*InfoRecord, 0, len(backup.Records))
+	for _, backupRecord := range backup.Records {
+		if isReadOnly(backupRecord.Tag) {
+			continue
+		}
+
+		infoRecord, err := backupRecord.infoRecord()
+		if nil != err {
+			return err
+		}
+
+		records = append(records, infoRecord)
+	}
+
+	return a.writeRecords(ctx, records...)
+}
@@ -0,0 +1,249 @@
+package airport_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"testing"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+func TestBackupRestore(t *testing.T) {
+	ctx := context.Background()
+	server := newServer(t, "secret")
+	server.Put(newRecord(t, "buil", "7.6.8"))
+	server.Put(newRecord(t, "syNm", "Office"))
+	server.Put(newRecord(t, "laIP", "10.0.1.1"))
+	server.Put(newRecord(t, "dhLe", "3600"))
+	server.Put(newRecord(t, "syPW", "community"))
+
+	var backup bytes.Buffer
+	if err := server.Airport().Backup(ctx, &backup); nil != err {
+		t.Fatal(err)
+	}
+
+	server.Put(newRecord(t, "syNm", "Changed"))
+	server.Put(newRecord(t, "laIP", "192.168.0.1"))
+	server.Put(newRecord(t, "dhLe", "60"))
+	server.Put(newRecord(t, "syPW", "other"))
+
+	if err := server.Airport().Restore(ctx, bytes.NewReader(backup.Bytes())); nil != err {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{"syNm": "Office", "laIP": "10.0.1.1", "dhLe": "3600", "syPW": "community", "buil": "7.6.8"}
+	for tag, value := range want {
+		if got := server.Get(tag).String(); value != got {
+			t.Errorf("%s = %q after Restore, want %q", tag, got, value)
+		}
+	}
+}
+
+func TestBackupRawValue(t *testing.T) {
+	ctx := context.Background()
+	server := newServer(t, "secret")
+	server.Put(newRecord(t, "buil", "7.6.8"))
+
+	// a one byte value for a four byte integer does not decode
+	channel := airport.GetInfoRecord("raCh")
+	channel.SetValue([]byte{0x01})
+	server.Put(channel)
+
+	var backup bytes.Buffer
+	if err := server.Airport().Backup(ctx, &backup); nil != err {
+		t.Fatal(err)
+	}
+
+	backupFile, err := airport.ReadBackupFile(bytes.NewReader(backup.Bytes()))
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, backupRecord := range backupFile.Records {
+		if "raCh" == backupRecord.Tag {
+			found = true
+			if !backupRecord.Raw || "01" != backupRecord.Value {
+				t.Errorf("raCh backed up as %+v, want raw 01", backupRecord)
+			}
+		}
+	}
+	if !found {
+		t.Fatal("raCh missing from backup")
+	}
+
+	server.Put(newRecord(t, "raCh", "6"))
+	if err := server.Airport().Restore(ctx, bytes.NewReader(backup.Bytes())); nil != err {
+		t.Fatal(err)
+	}
+
+	if got := server.Get("raCh").Value; !bytes.Equal([]byte{0x01}, got) {
+		t.Errorf("raCh = %x after Restore, want 01", got)
+	}
+}
+
+func TestRestoreBuildMismatch(t *testing.T) {
+	ctx := context.Background()
+	server := newServer(t, "secret")
+	server.Put(newRecord(t, "buil", "7.6.8"))
+
+	var backup bytes.Buffer
+	if err := server.Airport().Backup(ctx, &backup); nil != err {
+		t.Fatal(err)
+	}
+
+	server.Put(newRecord(t, "buil", "7.7.3"))
+	err := server.Airport().Restore(ctx, &backup)
+	if !errors.Is(err, airport.ErrBuildMismatch) {
+		t.Errorf("Restore() error = %v, want ErrBuildMismatch", err)
+	}
+}
+
+func TestRestoreWithoutBuild(t *testing.T) {
+	ctx := context.Background()
+
+	// stations that do not return buil are restored without the build check
+	for _, build := range []string{"", "7.6.8"} {
+		server := newServer(t, "secret")
+		server.Put(newRecord(t, "syNm", "Changed"))
+
+		backup, _ := json.Marshal(airport.BackupFile{
+			Version: airport.BackupVersion,
+			Build:   build,
+			Records: []airport.BackupRecord{{Tag: "syNm", DataType: "TypeCharString", Encrypted: true, Value: "Office"}},
+		})
+		if err := server.Airport().Restore(ctx, bytes.NewReader(backup)); nil != err {
+			t.Errorf("Restore() of build %q error = %v", build, err)
+		}
+
+		if got := server.Get("syNm").String(); "Office" != got {
+			t.Errorf("syNm = %q after Restore, want Office", got)
+		}
+	}
+}
+
+func TestRestoreMismatchedRecord(t *testing.T) {
+	tests := []struct {
+		name   string
+		record airport.BackupRecord
+	}{
+		{"data type", airport.BackupRecord{Tag: "syNm", DataType: "TypeByteString", Encrypted: true, Value: "4f6666696365"}},
+		{"encryption", airport.BackupRecord{Tag: "syNm", DataType: "TypeCharString", Encrypted: false, Value: "Office"}},
+	}
+
+	for _, test := range tests {
+		server := newServer(t, "secret")
+		server.Put(newRecord(t, "syNm", "Changed"))
+
+		backup, _ := json.Marshal(airport.BackupFile{Version: airport.BackupVersion, Records: []airport.BackupRecord{test.record}})
+		if err := server.Airport().Restore(context.Background(), bytes.NewReader(backup)); !errors.Is(err, airport.ErrInvalidBackup) {
+			t.Errorf("%s: Restore() error = %v, want ErrInvalidBackup", test.name, err)
+		}
+
+		if got := server.Get("syNm").String(); "Changed" != got {
+			t.Errorf("%s: syNm = %q after a failed Restore", test.name, got)
+		}
+	}
+}
+
+func TestReadBackupFileInvalid(t *testing.T) {
+	for _, data := range []string{"", "{", `{"version": 99}`} {
+		_, err := airport.ReadBackupFile(bytes.NewReader([]byte(data)))
+		if !errors.Is(err, airport.ErrInvalidBackup) {
+			t.Errorf("ReadBackupFile(%q) error = %v, want ErrInvalidBackup", data, err)
+		}
+	}
+
+	backupFile := airport.BackupFile{
+		Version: airport.BackupVersion,
+		Records: []airport.BackupRecord{{Tag: "laIP", DataType: "TypeIPAddress", Encrypted: true, Value: "not an address"}},
+	}
+	data, _ := json.Marshal(backupFile)
+	parsed, err := airport.ReadBackupFile(bytes.NewReader(data))
+	if nil != err {
+		t.Fatal(err)
+	}
+	if _, err = parsed.Info(); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("Info() error = %v, want ErrInvalidValue", err)
+	}
+}
+
+func TestBackupRestoreTables(t *testing.T) {
+	ctx := context.Background()
+	server := newServer(t, "secret")
+	server.Put(newRecord(t, "buil", "7.6.8"))
+	server.Put(newRecord(t, "laIP", "10.0.1.1"))
+	server.Put(newRecord(t, "laSM", "255.255.255.0"))
+
+	a := server.Airport()
+	list := &airport.AccessControlList{Enabled: true}
+	for _, mac := range []string{"00:11:22:33:44:01", "00:11:22:33:44:02", "00:11:22:33:44:03"} {
+		list.Add(mustMAC(t, mac), "host")
+	}
+	if err := a.SetAccessControl(ctx, list); nil != err {
+		t.Fatal(err)
+	}
+
+	mappings := []airport.PortMapping{
+		{Protocol: airport.ProtocolTCP, PublicPort: 80, PrivateIP: net.IPv4(10, 0, 1, 2), PrivatePort: 8080},
+		{Protocol: airport.ProtocolUDP, PublicPort: 53, PrivateIP: net.IPv4(10, 0, 1, 3), PrivatePort: 53},
+	}
+	for _, mapping := range mappings {
+		if err := a.AddPortMapping(ctx, mapping); nil != err {
+			t.Fatal(err)
+		}
+	}
+
+	var backup bytes.Buffer
+	if err := a.Backup(ctx, &backup); nil != err {
+		t.Fatal(err)
+	}
+
+	before, err := a.ReadAll(ctx)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	// the backup as a snapshot holds every row
+	backupFile, err := airport.ReadBackupFile(bytes.NewReader(backup.Bytes()))
+	if nil != err {
+		t.Fatal(err)
+	}
+	backupInfo, err := backupFile.Info()
+	if nil != err {
+		t.Fatal(err)
+	}
+	if changes := airport.Diff(before, backupInfo); 0 != len(changes) {
+		t.Errorf("backup differs from the station: %v", changes)
+	}
+
+	// shrink both tables, then restore them
+	if err = a.SetAccessControl(ctx, &airport.AccessControlList{}); nil != err {
+		t.Fatal(err)
+	}
+	if err = a.RemovePortMapping(ctx, airport.ProtocolUDP, 53); nil != err {
+		t.Fatal(err)
+	}
+
+	if err = a.Restore(ctx, bytes.NewReader(backup.Bytes())); nil != err {
+		t.Fatal(err)
+	}
+
+	after, err := a.ReadAll(ctx)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if changes := airport.Diff(before, after); 0 != len(changes) {
+		t.Errorf("Restore() left changes %v", changes)
+	}
+
+	if rows := server.GetAll("acTa"); 3 != len(rows) {
+		t.Errorf("server holds %d acTa rows after Restore, want 3", len(rows))
+	}
+	if rows := server.GetAll("pmTa"); 2 != len(rows) {
+		t.Errorf("server holds %d pmTa rows after Restore, want 2", len(rows))
+	}
+}
@@ -0,0 +1,122 @@
+package airport
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Codec converts record values between their wire form and their text form.
+// For every text Decode returns, Decode(Encode(text)) gives back the same
+// text. Wire values survive Encode(Decode(value)) as well, except for the NUL
+// padding of char strings, which stations ignore and Decode drops.
+type Codec interface {
+	// Decode returns the text form of a wire value.
+	Decode(value []byte) (string, error)
+	// Encode returns the wire form of a text value. Errors wrap
+	// ErrInvalidValue.
+	Encode(text string) ([]byte, error)
+}
+
+// CodecFor returns the codec for dataType. Unknown types are treated as
+// TypeByteString.
+func CodecFor(dataType RecordType) Codec {
+	switch dataType {
+	case TypeCharString, TypePhoneNumber:
+		return charStringCodec{}
+	case TypeIPAddress:
+		return ipAddressCodec{}
+	case TypeUnsignedInteger:
+		return unsignedIntegerCodec{order: binary.BigEndian}
+	case TypeLittleEndianUnsignedInteger:
+		return unsignedIntegerCodec{order: binary.LittleEndian}
+	}
+
+	return hexCodec{}
+}
+
+// codec returns the codec for the record's values: the one for its data
+// type, unless the tag holds named values, see enumTags.
+func (i *InfoRecord) codec() Codec {
+	if names, ok := enumTags[i.Tag]; ok && TypeByteString == i.DataType {
+		return enumCodec{names: names}
+	}
+
+	return CodecFor(i.DataType)
+}
+
+// charStringCodec handles text. Stations may pad values with NUL bytes,
+// which are dropped on decoding.
+type charStringCodec struct{}
+
+func (charStringCodec) Decode(value []byte) (string, error) {
+	return strings.TrimRight(string(value), "\x00"), nil
+}
+
+func (charStringCodec) Encode(text string) ([]byte, error) {
+	return []byte(text), nil
+}
+
+// ipAddressCodec handles IPv4 addresses in network byte order.
+type ipAddressCodec struct{}
+
+func (ipAddressCodec) Decode(value []byte) (string, error) {
+	if net.IPv4len != len(value) {
+		return "", fmt.Errorf("%w: IPv4 address is %d bytes, expected %d", ErrInvalidValue, len(value), net.IPv4len)
+	}
+
+	return net.IP(value).String(), nil
+}
+
+func (ipAddressCodec) Encode(text string) ([]byte, error) {
+	ip := net.ParseIP(text).To4()
+	if nil == ip {
+		return nil, fmt.Errorf("%w: %q is not an IPv4 address", ErrInvalidValue, text)
+	}
+
+	return ip, nil
+}
+
+// unsignedIntegerCodec handles 32 bit unsigned integers in decimal.
+type unsignedIntegerCodec struct {
+	order binary.ByteOrder
+}
+
+func (c unsignedIntegerCodec) Decode(value []byte) (string, error) {
+	if 4 != len(value) {
+		return "", fmt.Errorf("%w: unsigned integer is %d bytes, expected 4", ErrInvalidValue, len(value))
+	}
+
+	return strconv.FormatUint(uint64(c.order.Uint32(value)), 10), nil
+}
+
+func (c unsignedIntegerCodec) Encode(text string) ([]byte, error) {
+	parsed, err := strconv.ParseUint(text, 10, 32)
+	if nil != err {
+		return nil, fmt.Errorf("%w: %q is not an unsigned integer", ErrInvalidValue, text)
+	}
+
+	value := make([]byte, 4)
+	c.order.PutUint32(value, uint32(parsed))
+
+	return value, nil
+}
+
+// hexCodec handles raw bytes as lower case hex.
+type hexCodec struct{}
+
+func (hexCodec) Decode(value []byte) (string, error) {
+	return hex.EncodeToString(value), nil
+}
+
+func (hexCodec) Encode(text string) ([]byte, error) {
+	value, err := hex.DecodeString(text)
+	if nil != err {
+		return nil, fmt.Errorf("%w: %q is not a hex string", ErrInvalidValue, text)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,137 @@
+package airport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// randomValue returns a wire value for infoRecord that its codec decodes,
+// the way a station could send it.
+func randomValue(r *rand.Rand, infoRecord *InfoRecord) []byte {
+	maxLength := int(infoRecord.MaxLength)
+	if 0 >= maxLength {
+		maxLength = 4
+	}
+
+	switch infoRecord.DataType {
+	case TypeCharString, TypePhoneNumber:
+		text := make([]byte, r.Intn(int(infoRecord.maxTextLength())+1))
+		for index := range text {
+			text[index] = byte(0x20 + r.Intn(0x5f))
+		}
+
+		// stations pad text with NULs up to the record size
+		if r.Intn(2) == 0 {
+			text = append(text, make([]byte, maxLength-len(text))...)
+		}
+
+		return text
+	case TypeIPAddress, TypeUnsignedInteger, TypeLittleEndianUnsignedInteger:
+		value := make([]byte, 4)
+		binary.BigEndian.PutUint32(value, r.Uint32())
+
+		return value
+	}
+
+	if _, ok := enumTags[infoRecord.Tag]; ok {
+		value := make([]byte, 4)
+		binary.BigEndian.PutUint32(value, uint32(r.Intn(0x500)))
+
+		return value
+	}
+
+	value := make([]byte, r.Intn(maxLength+1))
+	r.Read(value)
+
+	return value
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, tag := range RegisteredTags() {
+		for n := 0; n < 200; n++ {
+			infoRecord := lookupInfoRecord(tag)
+			value := randomValue(r, infoRecord)
+			infoRecord.SetValue(value)
+
+			text, raw := infoRecord.text()
+			if raw {
+				t.Fatalf("%s: %x does not decode", tag, value)
+			}
+
+			encoded, err := infoRecord.parseString(text)
+			if nil != err {
+				t.Fatalf("%s: %q from %x does not encode: %v", tag, text, value, err)
+			}
+
+			want := value
+			if TypeCharString == infoRecord.DataType || TypePhoneNumber == infoRecord.DataType {
+				want = bytes.TrimRight(value, "\x00")
+			}
+			if !bytes.Equal(want, encoded) {
+				t.Fatalf("%s: Encode(Decode(%x)) = %x", tag, value, encoded)
+			}
+
+			infoRecord.SetValue(encoded)
+			if again := infoRecord.String(); text != again {
+				t.Fatalf("%s: Decode(Encode(%q)) = %q", tag, text, again)
+			}
+		}
+	}
+}
+
+func TestCodecFullLength(t *testing.T) {
+	// a build hash fills the whole record
+	build := lookupInfoRecord(TagBuildHash)
+	build.SetValue([]byte(strings.Repeat("a", int(build.MaxLength))))
+
+	encoded, err := build.parseString(build.String())
+	if nil != err || !bytes.Equal(build.Value, encoded) {
+		t.Errorf("buil: Encode(Decode(%q)) = %q, %v", build.Value, encoded, err)
+	}
+
+	// an all NUL name is the empty name
+	name := lookupInfoRecord(TagStationName)
+	name.SetValue(make([]byte, name.MaxLength))
+
+	if encoded, err = name.parseString(name.String()); nil != err || 0 != len(encoded) {
+		t.Errorf("syNm: Encode(Decode(NULs)) = %x, %v, want empty", encoded, err)
+	}
+
+	// written names still need room for the terminating NUL
+	if err = name.SetBytesFromString(strings.Repeat("a", int(name.MaxLength))); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("syNm: full length name error = %v, want ErrInvalidValue", err)
+	}
+}
+
+func TestCodecErrors(t *testing.T) {
+	tests := []struct {
+		dataType RecordType
+		text     string
+	}{
+		{TypeIPAddress, "10.0.1"},
+		{TypeIPAddress, "fe80::1"},
+		{TypeUnsignedInteger, "-1"},
+		{TypeUnsignedInteger, "4294967296"},
+		{TypeLittleEndianUnsignedInteger, "x"},
+		{TypeByteString, "0g"},
+		{TypeByte, "012"},
+	}
+
+	for _, test := range tests {
+		if _, err := CodecFor(test.dataType).Encode(test.text); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("%v: Encode(%q) error = %v, want ErrInvalidValue", test.dataType, test.text, err)
+		}
+	}
+
+	for _, dataType := range []RecordType{TypeIPAddress, TypeUnsignedInteger} {
+		if _, err := CodecFor(dataType).Decode([]byte{1}); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("%v: Decode(01) error = %v, want ErrInvalidValue", dataType, err)
+		}
+	}
+}
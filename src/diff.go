@@ -0,0 +1,190 @@
+package airport
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// ChangeKind tells how a record differs between two snapshots.
+type ChangeKind int
+
+const (
+	// ChangeAdded means the record is only present in the newer snapshot.
+	ChangeAdded ChangeKind = iota + 1
+	// ChangeRemoved means the record is only present in the older snapshot.
+	ChangeRemoved
+	// ChangeModified means the record is present in both with different values.
+	ChangeModified
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeAdded:
+		return "added"
+	case ChangeRemoved:
+		return "removed"
+	case ChangeModified:
+		return "modified"
+	}
+
+	return fmt.Sprintf("ChangeKind(%d)", int(k))
+}
+
+// Change describes a single record that differs between two snapshots.
+// Tables such as acTa are compared as a whole, row by row.
+type Change struct {
+	Tag  string
+	Kind ChangeKind
+	// Old and New are the records from the older and newer snapshot, nil
+	// when the record is absent from it. For tables they are the first row.
+	Old *InfoRecord
+	New *InfoRecord
+	// OldRows and NewRows hold all records for the tag, one per table row.
+	OldRows  []*InfoRecord
+	NewRows  []*InfoRecord
+	oldState RecordState
+	newState RecordState
+}
+
+// OldValue returns the decoded value in the older snapshot. The rows of
+// tables are separated by commas.
+func (c Change) OldValue() string {
+	return changeValue(c.OldRows, c.oldState)
+}
+
+// NewValue returns the decoded value in the newer snapshot. The rows of
+// tables are separated by commas.
+func (c Change) NewValue() string {
+	return changeValue(c.NewRows, c.newState)
+}
+
+func changeValue(rows []*InfoRecord, state RecordState) string {
+	if 0 == len(rows) {
+		return ""
+	}
+
+	if RecordInvalid == state {
+		return "<invalid>"
+	}
+
+	values := make([]string, 0, len(rows))
+	for _, infoRecord := range rows {
+		values = append(values, infoRecord.String())
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// equalRows reports whether two tables hold the same rows in the same order.
+func equalRows(a, b []*InfoRecord) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for index := range a {
+		if !bytes.Equal(a[index].Value, b[index].Value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Diff lists the records that were added, removed or changed going from a to
+// b, sorted by tag. Records a station did not return count as absent.
+func Diff(a, b *Info) []Change {
+	changes := make([]Change, 0)
+
+	seen := make(map[string]bool)
+	for _, tag := range append(a.Tags(), b.Tags()...) {
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+
+		oldState, newState := a.State(tag), b.State(tag)
+		change := Change{
+			Tag:      tag,
+			oldState: oldState,
+			newState: newState,
+		}
+		if RecordUnsupported != oldState {
+			change.Old = a.Get(tag)
+			change.OldRows = a.GetAll(tag)
+		}
+		if RecordUnsupported != newState {
+			change.New = b.Get(tag)
+			change.NewRows = b.GetAll(tag)
+		}
+
+		switch {
+		case nil == change.Old && nil == change.New:
+			continue
+		case nil == change.Old:
+			change.Kind = ChangeAdded
+		case nil == change.New:
+			change.Kind = ChangeRemoved
+		case oldState != newState || !equalRows(change.OldRows, change.NewRows):
+			change.Kind = ChangeModified
+		default:
+			continue
+		}
+
+		changes = append(changes, change)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Tag < changes[j].Tag
+	})
+
+	return changes
+}
+
+// DiffOption configures WriteDiff.
+type DiffOption func(*diffOptions)
+
+type diffOptions struct {
+	showSecrets bool
+}
+
+// WithSecrets makes WriteDiff show the values of secret tags instead of
+// masking them.
+func WithSecrets() DiffOption {
+	return func(o *diffOptions) {
+		o.showSecrets = true
+	}
+}
+
+// WriteDiff renders changes as text, one line per change. Values of secret
+// tags such as passwords and keys are masked unless WithSecrets is given.
+func WriteDiff(w io.Writer, changes []Change, opts ...DiffOption) error {
+	var options diffOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	for _, change := range changes {
+		oldValue, newValue := change.OldValue(), change.NewValue()
+		if isSecret(change.Tag) && !options.showSecrets {
+			oldValue, newValue = "********", "********"
+		}
+
+		var err error
+		switch change.Kind {
+		case ChangeAdded:
+			_, err = fmt.Fprintf(w, "+ %s = %s\n", change.Tag, newValue)
+		case ChangeRemoved:
+			_, err = fmt.Fprintf(w, "- %s = %s\n", change.Tag, oldValue)
+		default:
+			_, err = fmt.Fprintf(w, "~ %s: %s -> %s\n", change.Tag, oldValue, newValue)
+		}
+		if nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
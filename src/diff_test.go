@@ -0,0 +1,111 @@
+package airport_test
+
+import (
+	"bytes"
+	"testing"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+// parseRecords parses raw records, failing the test on errors.
+func parseRecords(t *testing.T, records ...[]byte) *airport.Info {
+	t.Helper()
+
+	info, err := airport.ParseInfo(bytes.Join(records, nil))
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return info
+}
+
+func TestDiff(t *testing.T) {
+	older := parseRecords(t,
+		rawRecord("syNm", 0, []byte("Office")),
+		rawRecord("raCh", 0, []byte{0, 0, 0, 6}),
+		rawRecord("syLo", 0, []byte("Attic")),
+		rawRecord("raNA", 0, []byte{1}),
+		rawRecord("syPW", 0, []byte("old")),
+	)
+	newer := parseRecords(t,
+		rawRecord("syNm", 0, []byte("Office")),
+		rawRecord("raCh", 0, []byte{0, 0, 0, 11}),
+		rawRecord("syCt", 0, []byte("Admin")),
+		rawRecord("raNA", 0, []byte{0xFF, 0xFF, 0xFF, 0xF6}),
+		rawRecord("syPW", 0, []byte("new")),
+	)
+
+	changes := airport.Diff(older, newer)
+
+	want := []struct {
+		tag      string
+		kind     airport.ChangeKind
+		old, new string
+	}{
+		{"raCh", airport.ChangeModified, "6", "11"},
+		{"raNA", airport.ChangeModified, "01", "<invalid>"},
+		{"syCt", airport.ChangeAdded, "", "Admin"},
+		{"syLo", airport.ChangeRemoved, "Attic", ""},
+		{"syPW", airport.ChangeModified, "old", "new"},
+	}
+
+	if len(want) != len(changes) {
+		t.Fatalf("Diff() = %v, want %d changes", changes, len(want))
+	}
+
+	for index, change := range changes {
+		w := want[index]
+		if w.tag != change.Tag || w.kind != change.Kind || w.old != change.OldValue() || w.new != change.NewValue() {
+			t.Errorf("change %d = %s %v %q -> %q, want %s %v %q -> %q", index,
+				change.Tag, change.Kind, change.OldValue(), change.NewValue(), w.tag, w.kind, w.old, w.new)
+		}
+	}
+
+	if 0 != len(airport.Diff(older, older)) {
+		t.Errorf("Diff() of a snapshot with itself is not empty")
+	}
+
+	var out bytes.Buffer
+	if err := airport.WriteDiff(&out, changes); nil != err {
+		t.Fatal(err)
+	}
+
+	wantText := "~ raCh: 6 -> 11\n~ raNA: 01 -> <invalid>\n+ syCt = Admin\n- syLo = Attic\n~ syPW: ******** -> ********\n"
+	if wantText != out.String() {
+		t.Errorf("WriteDiff() = %q, want %q", out.String(), wantText)
+	}
+
+	out.Reset()
+	if err := airport.WriteDiff(&out, changes[4:], airport.WithSecrets()); nil != err {
+		t.Fatal(err)
+	}
+
+	if "~ syPW: old -> new\n" != out.String() {
+		t.Errorf("WriteDiff(WithSecrets()) = %q", out.String())
+	}
+}
+
+func TestDiffTables(t *testing.T) {
+	older := parseRecords(t,
+		rawRecord("pmTa", 0, []byte{1}),
+		rawRecord("pmTa", 0, []byte{2}),
+		rawRecord("pmTa", 0, []byte{3}),
+	)
+	newer := parseRecords(t,
+		rawRecord("pmTa", 0, []byte{1}),
+		rawRecord("pmTa", 0, []byte{2}),
+	)
+
+	changes := airport.Diff(older, newer)
+	if 1 != len(changes) || airport.ChangeModified != changes[0].Kind {
+		t.Fatalf("Diff() = %v, want pmTa modified", changes)
+	}
+
+	if "01, 02, 03" != changes[0].OldValue() || "01, 02" != changes[0].NewValue() {
+		t.Errorf("Diff() = %q -> %q", changes[0].OldValue(), changes[0].NewValue())
+	}
+
+	if 0 != len(airport.Diff(older, older)) {
+		t.Errorf("Diff() of a table with itself is not empty")
+	}
+}
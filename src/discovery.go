@@ -0,0 +1,246 @@
+package airport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DiscoveryPort is the UDP port base stations answer discovery requests on.
+const DiscoveryPort = 192
+
+// DefaultDiscoveryWait is how long Discover collects answers when its
+// context has no deadline.
+const DefaultDiscoveryWait = 3 * time.Second
+
+// discoveryPacketSize is the size of discovery requests and answers.
+const discoveryPacketSize = 116
+
+// Offsets of the fields of a discovery packet.
+const (
+	discoveryTypeOffset    = 0x00
+	discoveryMACOffset     = 0x24
+	discoveryNameOffset    = 0x30
+	discoveryAddressOffset = 0x50
+	discoveryModelOffset   = 0x54
+
+	discoveryNameSize  = discoveryAddressOffset - discoveryNameOffset
+	discoveryModelSize = discoveryPacketSize - discoveryModelOffset
+)
+
+// discoveryType marks discovery requests and answers.
+const discoveryType = 0x01
+
+// StationInfo describes a base station that answered Discover.
+type StationInfo struct {
+	Address net.IP
+	Name    string
+	MAC     net.HardwareAddr
+	Model   string
+}
+
+// Airport returns a client for the station.
+func (s StationInfo) Airport(opts ...Option) (*Airport, error) {
+	return New(s.Address.String(), opts...)
+}
+
+func (s StationInfo) String() string {
+	return fmt.Sprintf("%s %s (%s, %s)", s.Address, s.Name, s.MAC, s.Model)
+}
+
+// MarshalBinary encodes the station as a 116 byte discovery answer. Name and
+// model are NUL padded, the address is IPv4.
+func (s StationInfo) MarshalBinary() ([]byte, error) {
+	data := make([]byte, discoveryPacketSize)
+	data[discoveryTypeOffset] = discoveryType
+
+	if 0 != len(s.MAC) {
+		if 6 != len(s.MAC) {
+			return nil, fmt.Errorf("%w: MAC address %s is not 6 bytes", ErrInvalidValue, s.MAC)
+		}
+		copy(data[discoveryMACOffset:], s.MAC)
+	}
+
+	if len(s.Name) > discoveryNameSize {
+		return nil, fmt.Errorf("%w: name is %d bytes, maximum %d", ErrInvalidValue, len(s.Name), discoveryNameSize)
+	}
+	copy(data[discoveryNameOffset:], s.Name)
+
+	if nil != s.Address {
+		ip := s.Address.To4()
+		if nil == ip {
+			return nil, fmt.Errorf("%w: %s is not an IPv4 address", ErrInvalidValue, s.Address)
+		}
+		copy(data[discoveryAddressOffset:], ip)
+	}
+
+	if len(s.Model) > discoveryModelSize {
+		return nil, fmt.Errorf("%w: model is %d bytes, maximum %d", ErrInvalidValue, len(s.Model), discoveryModelSize)
+	}
+	copy(data[discoveryModelOffset:], s.Model)
+
+	return data, nil
+}
+
+// UnmarshalBinary decodes a discovery answer. Fields the station leaves
+// empty stay nil or empty.
+func (s *StationInfo) UnmarshalBinary(data []byte) error {
+	if discoveryPacketSize != len(data) {
+		return fmt.Errorf("%w: discovery answer is %d bytes, expected %d", ErrInvalidMessage, len(data), discoveryPacketSize)
+	}
+
+	if discoveryType != data[discoveryTypeOffset] {
+		return fmt.Errorf("%w: discovery answer type is %#x, expected %#x", ErrInvalidMessage, data[discoveryTypeOffset], discoveryType)
+	}
+
+	*s = StationInfo{
+		Name:  discoveryString(data[discoveryNameOffset:discoveryAddressOffset]),
+		Model: discoveryString(data[discoveryModelOffset:]),
+	}
+
+	if mac := data[discoveryMACOffset : discoveryMACOffset+6]; !isZero(mac) {
+		s.MAC = append(net.HardwareAddr(nil), mac...)
+	}
+
+	if ip := data[discoveryAddressOffset : discoveryAddressOffset+net.IPv4len]; !isZero(ip) {
+		s.Address = net.IPv4(ip[0], ip[1], ip[2], ip[3])
+	}
+
+	return nil
+}
+
+func discoveryString(field []byte) string {
+	if end := bytes.IndexByte(field, 0); 0 <= end {
+		field = field[:end]
+	}
+
+	return strings.TrimSpace(string(field))
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if 0 != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DiscoverOption configures Discover.
+type DiscoverOption func(*discoverOptions)
+
+type discoverOptions struct {
+	target    *net.UDPAddr
+	wait      time.Duration
+	localAddr net.IP
+}
+
+// WithDiscoveryTarget sends the request to addr instead of broadcasting it,
+// for example to a single subnet's broadcast address or, in tests, to a
+// local responder.
+func WithDiscoveryTarget(addr *net.UDPAddr) DiscoverOption {
+	return func(o *discoverOptions) {
+		o.target = addr
+	}
+}
+
+// WithDiscoveryWait sets how long to collect answers when the context has no
+// deadline.
+func WithDiscoveryWait(wait time.Duration) DiscoverOption {
+	return func(o *discoverOptions) {
+		o.wait = wait
+	}
+}
+
+// WithDiscoveryLocalAddr sends the request from the given local address, to
+// pick the interface to discover on.
+func WithDiscoveryLocalAddr(ip net.IP) DiscoverOption {
+	return func(o *discoverOptions) {
+		o.localAddr = ip
+	}
+}
+
+// Discover finds base stations on the local network with the legacy AirPort
+// UDP broadcast discovery. It collects answers until ctx is done or, without
+// a deadline, for DefaultDiscoveryWait, and returns the stations sorted by
+// address. Stations that answer more than once are only listed once.
+func Discover(ctx context.Context, opts ...DiscoverOption) ([]StationInfo, error) {
+	options := discoverOptions{
+		target: &net.UDPAddr{IP: net.IPv4bcast, Port: DiscoveryPort},
+		wait:   DefaultDiscoveryWait,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.wait)
+		defer cancel()
+	}
+
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: options.localAddr})
+	if nil != err {
+		return nil, err
+	}
+
+	defer conn.Close()
+	stop := watchConnection(ctx, conn)
+	defer stop()
+
+	request := make([]byte, discoveryPacketSize)
+	request[discoveryTypeOffset] = discoveryType
+
+	_, err = conn.WriteToUDP(request, options.target)
+	if nil != err {
+		return nil, contextError(ctx, err)
+	}
+
+	var stations []StationInfo
+	seen := make(map[string]bool)
+	answer := make([]byte, discoveryPacketSize+1)
+	for {
+		n, from, err := conn.ReadFromUDP(answer)
+		if nil != err {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				// the wait is over
+				break
+			}
+
+			return stations, contextError(ctx, err)
+		}
+
+		var station StationInfo
+		if err := station.UnmarshalBinary(answer[:n]); nil != err || bytes.Equal(answer[:n], request) {
+			// not an answer, or our own broadcast
+			continue
+		}
+
+		if nil == station.Address {
+			station.Address = from.IP
+		}
+
+		key := station.Address.String()
+		if nil != station.MAC {
+			key = station.MAC.String()
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		stations = append(stations, station)
+	}
+
+	sort.Slice(stations, func(i, j int) bool {
+		return bytes.Compare(stations[i].Address.To16(), stations[j].Address.To16()) < 0
+	})
+
+	return stations, nil
+}
@@ -0,0 +1,86 @@
+package airport_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	airport "github.com/jutaz/go-airport/src"
+	"github.com/jutaz/go-airport/src/airporttest"
+)
+
+func TestStationInfoBinary(t *testing.T) {
+	station := airport.StationInfo{
+		Address: net.IPv4(10, 0, 1, 1),
+		Name:    "Office",
+		MAC:     mustMAC(t, "00:11:22:33:44:55"),
+		Model:   "AirPort Extreme",
+	}
+
+	data, err := station.MarshalBinary()
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	var decoded airport.StationInfo
+	if err = decoded.UnmarshalBinary(data); nil != err {
+		t.Fatal(err)
+	}
+
+	if station.String() != decoded.String() {
+		t.Errorf("UnmarshalBinary() = %v, want %v", decoded, station)
+	}
+
+	if err = decoded.UnmarshalBinary(data[:100]); !errors.Is(err, airport.ErrInvalidMessage) {
+		t.Errorf("UnmarshalBinary(short) error = %v, want ErrInvalidMessage", err)
+	}
+
+	data[0] = 0x02
+	if err = decoded.UnmarshalBinary(data); !errors.Is(err, airport.ErrInvalidMessage) {
+		t.Errorf("UnmarshalBinary(wrong type) error = %v, want ErrInvalidMessage", err)
+	}
+
+	station.Address = net.ParseIP("fe80::1")
+	if _, err = station.MarshalBinary(); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("MarshalBinary(IPv6) error = %v, want ErrInvalidValue", err)
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	station := airport.StationInfo{Name: "Office", MAC: mustMAC(t, "00:11:22:33:44:55"), Model: "AirPort"}
+
+	responder, err := airporttest.NewDiscoveryResponder(station)
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer responder.Close()
+
+	stations, err := airport.Discover(context.Background(),
+		airport.WithDiscoveryTarget(responder.Addr()),
+		airport.WithDiscoveryWait(200*time.Millisecond))
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if 1 != len(stations) {
+		t.Fatalf("Discover() = %v, want one station", stations)
+	}
+
+	// stations that leave the address empty are known by the sender
+	found := stations[0]
+	if "Office" != found.Name || !found.Address.Equal(net.IPv4(127, 0, 0, 1)) || station.MAC.String() != found.MAC.String() {
+		t.Errorf("Discover() = %v", found)
+	}
+}
+
+func TestDiscoverCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := airport.Discover(ctx, airport.WithDiscoveryTarget(&net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 9}))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Discover() error = %v, want context.Canceled", err)
+	}
+}
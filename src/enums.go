@@ -0,0 +1,252 @@
+package airport
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// The values of the enums below are not documented by Apple. They are the
+// ones the Java AirPort Base Station Configurator writes, the tool the tag
+// descriptions in tags.json come from. Values missing here are still read and
+// written, as hex.
+
+// ConfigMode is the value of TagConfigurationMode, how the station gets its
+// WAN address.
+type ConfigMode uint32
+
+const (
+	// ConfigModeManual uses the configured WAN address.
+	ConfigModeManual ConfigMode = 0x00000200
+	// ConfigModeDHCP gets the WAN address from a DHCP server.
+	ConfigModeDHCP ConfigMode = 0x00000300
+	// ConfigModePPPoE connects through PPP over Ethernet.
+	ConfigModePPPoE ConfigMode = 0x00000400
+)
+
+// WANInterface is the value of TagWANInterface, the port the station uses to
+// reach the internet.
+type WANInterface uint32
+
+const (
+	// WANInterfaceModem uses the built-in modem.
+	WANInterfaceModem WANInterface = 0x00000004
+	// WANInterfaceEthernet uses the Ethernet WAN port.
+	WANInterfaceEthernet WANInterface = 0x00000010
+)
+
+// EncryptionMode is the value of TagEncryptionSwitch, the WEP key size in
+// use.
+type EncryptionMode uint32
+
+const (
+	// EncryptionModeOff disables WEP.
+	EncryptionModeOff EncryptionMode = 0
+	// EncryptionModeWEP40 uses 40 bit keys.
+	EncryptionModeWEP40 EncryptionMode = 1
+	// EncryptionModeWEP128 uses 128 bit keys.
+	EncryptionModeWEP128 EncryptionMode = 2
+)
+
+// Density is the value of TagAccessPointDensity, which tunes roaming for the
+// number of access points around.
+type Density uint32
+
+const (
+	// DensityLow suits a single access point.
+	DensityLow Density = 1
+	// DensityMedium suits a few access points.
+	DensityMedium Density = 2
+	// DensityHigh suits many access points close together.
+	DensityHigh Density = 3
+)
+
+// MulticastRate is the value of TagMulticastRate, in the 500 kbit/s units
+// 802.11 uses for rates.
+type MulticastRate uint32
+
+const (
+	// MulticastRate1Mbps is 1 Mbit/s.
+	MulticastRate1Mbps MulticastRate = 0x02
+	// MulticastRate2Mbps is 2 Mbit/s.
+	MulticastRate2Mbps MulticastRate = 0x04
+	// MulticastRate5500Kbps is 5.5 Mbit/s.
+	MulticastRate5500Kbps MulticastRate = 0x0B
+	// MulticastRate11Mbps is 11 Mbit/s.
+	MulticastRate11Mbps MulticastRate = 0x16
+)
+
+// enumNames maps the values of a named enum to their lower case names.
+type enumNames map[uint32]string
+
+var (
+	configModeNames = enumNames{
+		uint32(ConfigModeManual): "manual",
+		uint32(ConfigModeDHCP):   "dhcp",
+		uint32(ConfigModePPPoE):  "pppoe",
+	}
+	wanInterfaceNames = enumNames{
+		uint32(WANInterfaceModem):    "modem",
+		uint32(WANInterfaceEthernet): "ethernet",
+	}
+	encryptionModeNames = enumNames{
+		uint32(EncryptionModeOff):    "off",
+		uint32(EncryptionModeWEP40):  "wep40",
+		uint32(EncryptionModeWEP128): "wep128",
+	}
+	densityNames = enumNames{
+		uint32(DensityLow):    "low",
+		uint32(DensityMedium): "medium",
+		uint32(DensityHigh):   "high",
+	}
+	multicastRateNames = enumNames{
+		uint32(MulticastRate1Mbps):    "1mbps",
+		uint32(MulticastRate2Mbps):    "2mbps",
+		uint32(MulticastRate5500Kbps): "5.5mbps",
+		uint32(MulticastRate11Mbps):   "11mbps",
+	}
+)
+
+// enumTags maps tags holding named values to their names.
+var enumTags = map[string]enumNames{
+	TagConfigurationMode:  configModeNames,
+	TagWANInterface:       wanInterfaceNames,
+	TagEncryptionSwitch:   encryptionModeNames,
+	TagAccessPointDensity: densityNames,
+	TagMulticastRate:      multicastRateNames,
+}
+
+// format returns the name of value, or typeName(value) in hex for values
+// without a name.
+func (n enumNames) format(typeName string, value uint32) string {
+	if name, ok := n[value]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("%s(%#x)", typeName, value)
+}
+
+// parse returns the value named name, in any case.
+func (n enumNames) parse(name string) (uint32, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for value, candidate := range n {
+		if candidate == name {
+			return value, true
+		}
+	}
+
+	return 0, false
+}
+
+// list returns the names, sorted by value, for error messages.
+func (n enumNames) list() string {
+	values := make([]uint32, 0, len(n))
+	for value := range n {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(a, b int) bool { return values[a] < values[b] })
+
+	names := make([]string, 0, len(values))
+	for _, value := range values {
+		names = append(names, n[value])
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// parseEnum parses name for ParseConfigMode and friends.
+func (n enumNames) parseEnum(kind string, name string) (uint32, error) {
+	value, ok := n.parse(name)
+	if !ok {
+		return 0, fmt.Errorf("%w: unknown %s %q, expected one of %s", ErrInvalidValue, kind, name, n.list())
+	}
+
+	return value, nil
+}
+
+func (m ConfigMode) String() string {
+	return configModeNames.format("ConfigMode", uint32(m))
+}
+
+// ParseConfigMode parses the names returned by String, in any case.
+func ParseConfigMode(name string) (ConfigMode, error) {
+	value, err := configModeNames.parseEnum("configuration mode", name)
+	return ConfigMode(value), err
+}
+
+func (w WANInterface) String() string {
+	return wanInterfaceNames.format("WANInterface", uint32(w))
+}
+
+// ParseWANInterface parses the names returned by String, in any case.
+func ParseWANInterface(name string) (WANInterface, error) {
+	value, err := wanInterfaceNames.parseEnum("WAN interface", name)
+	return WANInterface(value), err
+}
+
+func (m EncryptionMode) String() string {
+	return encryptionModeNames.format("EncryptionMode", uint32(m))
+}
+
+// ParseEncryptionMode parses the names returned by String, in any case.
+func ParseEncryptionMode(name string) (EncryptionMode, error) {
+	value, err := encryptionModeNames.parseEnum("encryption mode", name)
+	return EncryptionMode(value), err
+}
+
+func (d Density) String() string {
+	return densityNames.format("Density", uint32(d))
+}
+
+// ParseDensity parses the names returned by String, in any case.
+func ParseDensity(name string) (Density, error) {
+	value, err := densityNames.parseEnum("density", name)
+	return Density(value), err
+}
+
+func (r MulticastRate) String() string {
+	return multicastRateNames.format("MulticastRate", uint32(r))
+}
+
+// ParseMulticastRate parses the names returned by String, in any case, such
+// as "11mbps" or "5.5Mbps".
+func ParseMulticastRate(name string) (MulticastRate, error) {
+	value, err := multicastRateNames.parseEnum("multicast rate", name)
+	return MulticastRate(value), err
+}
+
+// enumCodec shows 4 byte values by name. Values without a name fall back to
+// 8 hex digits, which Encode accepts as well. Values of any other length do
+// not decode.
+type enumCodec struct {
+	names enumNames
+}
+
+func (c enumCodec) Decode(value []byte) (string, error) {
+	if 4 != len(value) {
+		return "", fmt.Errorf("%w: enum value is %d bytes, expected 4", ErrInvalidValue, len(value))
+	}
+
+	if name, ok := c.names[binary.BigEndian.Uint32(value)]; ok {
+		return name, nil
+	}
+
+	return hexCodec{}.Decode(value)
+}
+
+func (c enumCodec) Encode(text string) ([]byte, error) {
+	if parsed, ok := c.names.parse(text); ok {
+		value := make([]byte, 4)
+		binary.BigEndian.PutUint32(value, parsed)
+
+		return value, nil
+	}
+
+	value, err := hexCodec{}.Encode(text)
+	if nil != err || 4 != len(value) {
+		return nil, fmt.Errorf("%w: %q is not one of %s or 8 hex digits", ErrInvalidValue, text, c.names.list())
+	}
+
+	return value, nil
+}
@@ -0,0 +1,80 @@
+package airport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+func TestEnumRoundTrip(t *testing.T) {
+	for tag, names := range enumTags {
+		infoRecord := lookupInfoRecord(tag)
+
+		for value, name := range names {
+			wire := make([]byte, 4)
+			binary.BigEndian.PutUint32(wire, value)
+			infoRecord.SetValue(wire)
+
+			if got := infoRecord.String(); name != got {
+				t.Errorf("%s: %x = %q, want %q", tag, wire, got, name)
+			}
+
+			encoded, err := infoRecord.parseString(name)
+			if nil != err || !bytes.Equal(wire, encoded) {
+				t.Errorf("%s: %q = %x, %v, want %x", tag, name, encoded, err, wire)
+			}
+		}
+
+		// values without a name are 8 hex digits
+		infoRecord.SetValue([]byte{0, 0, 0x12, 0x34})
+		if got := infoRecord.String(); "00001234" != got {
+			t.Errorf("%s: unnamed value = %q, want 00001234", tag, got)
+		}
+		if encoded, err := infoRecord.parseString("00001234"); nil != err || !bytes.Equal(infoRecord.Value, encoded) {
+			t.Errorf("%s: 00001234 = %x, %v", tag, encoded, err)
+		}
+	}
+}
+
+func TestEnumCodecErrors(t *testing.T) {
+	infoRecord := lookupInfoRecord(TagConfigurationMode)
+
+	for _, text := range []string{"0102", "0000000102", "automatic", ""} {
+		if _, err := infoRecord.parseString(text); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("waCV: %q error = %v, want ErrInvalidValue", text, err)
+		}
+	}
+
+	// other lengths do not decode and show as raw hex
+	infoRecord.SetValue([]byte{1, 2})
+	if text, raw := infoRecord.text(); !raw || "0102" != text {
+		t.Errorf("waCV: 0102 = %q raw %v, want raw 0102", text, raw)
+	}
+}
+
+func TestParseEnums(t *testing.T) {
+	if mode, err := ParseConfigMode(" DHCP "); nil != err || ConfigModeDHCP != mode {
+		t.Errorf("ParseConfigMode() = %v, %v", mode, err)
+	}
+	if iface, err := ParseWANInterface("Ethernet"); nil != err || WANInterfaceEthernet != iface {
+		t.Errorf("ParseWANInterface() = %v, %v", iface, err)
+	}
+	if mode, err := ParseEncryptionMode("wep40"); nil != err || EncryptionModeWEP40 != mode {
+		t.Errorf("ParseEncryptionMode() = %v, %v", mode, err)
+	}
+	if density, err := ParseDensity("high"); nil != err || DensityHigh != density {
+		t.Errorf("ParseDensity() = %v, %v", density, err)
+	}
+	if rate, err := ParseMulticastRate("5.5Mbps"); nil != err || MulticastRate5500Kbps != rate {
+		t.Errorf("ParseMulticastRate() = %v, %v", rate, err)
+	}
+
+	if _, err := ParseDensity("extreme"); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("ParseDensity(extreme) error = %v, want ErrInvalidValue", err)
+	}
+
+	if got := ConfigMode(0x500).String(); "ConfigMode(0x500)" != got {
+		t.Errorf("ConfigMode(0x500).String() = %q", got)
+	}
+}
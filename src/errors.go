@@ -0,0 +1,85 @@
+package airport
+
+import (
+	"errors"
+	"fmt"
+)
+
+// StatusAuthenticationFailed is the status code a station answers with when
+// the request carried the wrong password.
+const StatusAuthenticationFailed int32 = -6
+
+var (
+	// ErrAuthenticationFailed is returned when the station rejects the password.
+	ErrAuthenticationFailed = errors.New("airport: authentication failed")
+	// ErrUnknownTag is returned when a tag is not registered or not returned by the station.
+	ErrUnknownTag = errors.New("airport: unknown tag")
+	// ErrChecksumMismatch is returned when a header or payload checksum does not match.
+	ErrChecksumMismatch = errors.New("airport: checksum mismatch")
+	// ErrTruncatedResponse is returned when a message or record ends early.
+	ErrTruncatedResponse = errors.New("airport: truncated response")
+	// ErrInvalidMessage is returned for messages that are not ACP messages at all.
+	ErrInvalidMessage = errors.New("airport: invalid message")
+	// ErrMalformedRecord is returned for records with an invalid tag, encryption or length.
+	ErrMalformedRecord = errors.New("airport: malformed record")
+	// ErrInvalidValue is returned when a value does not fit its record's type or length.
+	ErrInvalidValue = errors.New("airport: invalid value")
+	// ErrTypeMismatch is returned when a record is accessed as a type it does not have.
+	ErrTypeMismatch = errors.New("airport: type mismatch")
+	// ErrInvalidBackup is returned for backup files Restore cannot read.
+	ErrInvalidBackup = errors.New("airport: invalid backup")
+	// ErrBuildMismatch is returned when restoring a backup taken from another firmware build.
+	ErrBuildMismatch = errors.New("airport: firmware build mismatch")
+	// ErrPortMappingConflict is returned when a public port would be mapped twice.
+	ErrPortMappingConflict = errors.New("airport: conflicting port mapping")
+	// ErrPortMappingNotFound is returned when removing a port mapping that does not exist.
+	ErrPortMappingNotFound = errors.New("airport: port mapping not found")
+	// ErrTagRegistered is returned when registering a tag that is already registered.
+	ErrTagRegistered = errors.New("airport: tag already registered")
+	// ErrReadOnlyTag is returned when writing a read-only tag.
+	ErrReadOnlyTag = errors.New("airport: read-only tag")
+	// ErrHalfCloseUnsupported is returned for writes over connections without CloseWrite, see Dialer.
+	ErrHalfCloseUnsupported = errors.New("airport: connection cannot be half-closed")
+	// ErrInvalidRecord is returned when the station answers with the invalid marker instead of a value.
+	ErrInvalidRecord = errors.New("airport: station returned no valid value")
+)
+
+// StationError is returned when the station answers with a non-zero status.
+type StationError struct {
+	Code int32
+}
+
+func (e *StationError) Error() string {
+	return fmt.Sprintf("airport: station returned status %d", e.Code)
+}
+
+// Is lets errors.Is match status codes against the sentinel errors above.
+func (e *StationError) Is(target error) bool {
+	switch e.Code {
+	case StatusAuthenticationFailed:
+		return target == ErrAuthenticationFailed
+	}
+
+	return false
+}
+
+// ParseError reports a record that could not be parsed.
+type ParseError struct {
+	// Offset is the byte offset of the record within the parsed data.
+	Offset int64
+	// Tag is the record's tag, if it could be read.
+	Tag string
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	if "" == e.Tag {
+		return fmt.Sprintf("airport: record at offset %d: %v", e.Offset, e.Err)
+	}
+
+	return fmt.Sprintf("airport: record %q at offset %d: %v", e.Tag, e.Offset, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
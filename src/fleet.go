@@ -0,0 +1,144 @@
+package airport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Fleet runs operations on many stations at once.
+type Fleet struct {
+	Stations []*Airport
+	// Concurrency bounds how many stations are worked on at once. Zero means
+	// all of them.
+	Concurrency int
+	// Timeout bounds the operation on every single station. Zero leaves it to
+	// ctx and the stations' own timeouts.
+	Timeout time.Duration
+}
+
+// NewFleet returns a fleet of stations without concurrency or timeout limits.
+func NewFleet(stations ...*Airport) *Fleet {
+	return &Fleet{Stations: stations}
+}
+
+// FleetResult is the outcome of an operation on one station.
+type FleetResult struct {
+	Station *Airport
+	// Info holds what ReadAll read, nil for other operations.
+	Info *Info
+	Err  error
+}
+
+// FleetReport holds one result per station, in the order of Fleet.Stations.
+type FleetReport []FleetResult
+
+// Failed returns the results with an error.
+func (r FleetReport) Failed() FleetReport {
+	var failed FleetReport
+	for _, result := range r {
+		if nil != result.Err {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// Err joins the errors of all failed stations, each prefixed with the
+// station address, or returns nil when all succeeded.
+func (r FleetReport) Err() error {
+	var errs []error
+	for _, result := range r.Failed() {
+		errs = append(errs, fmt.Errorf("%s: %w", result.Station.address(), result.Err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Do calls fn for every station, at most Concurrency at a time. Each call
+// gets its own context, limited by Timeout. Stations not yet started when ctx
+// is done fail with its error. The returned error is FleetReport.Err.
+func (f *Fleet) Do(ctx context.Context, fn func(ctx context.Context, a *Airport) error) (FleetReport, error) {
+	return f.run(ctx, func(ctx context.Context, result *FleetResult) error {
+		return fn(ctx, result.Station)
+	})
+}
+
+// ReadAll reads every registered tag plus any extra tags from all stations,
+// see Airport.ReadAll. The records are in FleetResult.Info.
+func (f *Fleet) ReadAll(ctx context.Context, extra ...string) (FleetReport, error) {
+	return f.run(ctx, func(ctx context.Context, result *FleetResult) error {
+		info, err := result.Station.ReadAll(ctx, extra...)
+		result.Info = info
+
+		return err
+	})
+}
+
+// SetProperties writes the same values to all stations, see
+// Airport.SetProperties.
+func (f *Fleet) SetProperties(ctx context.Context, values map[string]string) (FleetReport, error) {
+	return f.Do(ctx, func(ctx context.Context, a *Airport) error {
+		return a.SetProperties(ctx, values)
+	})
+}
+
+// Reboot reboots all stations.
+func (f *Fleet) Reboot(ctx context.Context) (FleetReport, error) {
+	return f.Do(ctx, func(ctx context.Context, a *Airport) error {
+		return a.Reboot(ctx)
+	})
+}
+
+func (f *Fleet) run(ctx context.Context, fn func(ctx context.Context, result *FleetResult) error) (FleetReport, error) {
+	report := make(FleetReport, len(f.Stations))
+
+	concurrency := f.Concurrency
+	if 0 >= concurrency || concurrency > len(f.Stations) {
+		concurrency = len(f.Stations)
+	}
+	slots := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i, station := range f.Stations {
+		report[i].Station = station
+
+		if nil != ctx.Err() {
+			report[i].Err = ctx.Err()
+			continue
+		}
+
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			report[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *FleetResult) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			stationCtx, cancel := f.withTimeout(ctx)
+			defer cancel()
+
+			result.Err = fn(stationCtx, result)
+		}(&report[i])
+	}
+	wg.Wait()
+
+	return report, report.Err()
+}
+
+// withTimeout applies the per-station timeout to ctx.
+func (f *Fleet) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if 0 >= f.Timeout {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, f.Timeout)
+}
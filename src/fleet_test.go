@@ -0,0 +1,102 @@
+package airport_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	airport "github.com/jutaz/go-airport/src"
+	"github.com/jutaz/go-airport/src/airporttest"
+)
+
+// unreachable returns a client for a port nothing listens on.
+func unreachable(t *testing.T) *airport.Airport {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if nil != err {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	a, err := airport.New(addr, airport.WithTimeout(time.Second))
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return a
+}
+
+func TestFleet(t *testing.T) {
+	ctx := context.Background()
+
+	first, second := newServer(t, "secret"), newServer(t, "secret")
+	first.Put(newRecord(t, "syNm", "First"))
+	second.Put(newRecord(t, "syNm", "Second"))
+	down := unreachable(t)
+
+	fleet := airport.NewFleet(first.Airport(), down, second.Airport())
+	fleet.Concurrency = 2
+
+	report, err := fleet.ReadAll(ctx)
+	if nil == err || !strings.Contains(err.Error(), down.Address.String()) {
+		t.Errorf("ReadAll() error = %v, want one naming the unreachable station", err)
+	}
+
+	if 3 != len(report) || 1 != len(report.Failed()) || down != report.Failed()[0].Station {
+		t.Fatalf("ReadAll() report = %+v", report)
+	}
+
+	for index, name := range map[int]string{0: "First", 2: "Second"} {
+		if got := report[index].Info.Get("syNm").String(); name != got {
+			t.Errorf("station %d name = %q, want %q", index, got, name)
+		}
+	}
+
+	fleet.Stations = []*airport.Airport{first.Airport(), second.Airport()}
+	if _, err = fleet.SetProperties(ctx, map[string]string{"syLo": "Attic"}); nil != err {
+		t.Fatal(err)
+	}
+
+	for _, server := range []*airporttest.Server{first, second} {
+		if got := server.Get("syLo").String(); "Attic" != got {
+			t.Errorf("syLo = %q, want Attic", got)
+		}
+	}
+}
+
+func TestFleetCanceled(t *testing.T) {
+	server := newServer(t, "secret")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	report, err := airport.NewFleet(server.Airport(), server.Airport()).Do(ctx, func(ctx context.Context, a *airport.Airport) error {
+		t.Error("fn called after ctx was canceled")
+		return nil
+	})
+
+	if !errors.Is(err, context.Canceled) || 2 != len(report.Failed()) {
+		t.Errorf("Do() = %+v, %v, want every station canceled", report, err)
+	}
+}
+
+func TestFleetTimeout(t *testing.T) {
+	server := newServer(t, "secret")
+
+	fleet := airport.NewFleet(server.Airport())
+	fleet.Timeout = 50 * time.Millisecond
+
+	_, err := fleet.Do(context.Background(), func(ctx context.Context, a *airport.Airport) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Do() error = %v, want context.DeadlineExceeded", err)
+	}
+}
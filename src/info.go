@@ -3,113 +3,254 @@ package airport
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
+	"sort"
 )
 
+var invalidBytes = []byte{0xFF, 0xFF, 0xFF, 0xF6}
+
+// RecordState tells whether and how the station returned a record.
+type RecordState int
+
+const (
+	// RecordUnsupported means the station did not return the record.
+	RecordUnsupported RecordState = iota
+	// RecordInvalid means the station returned the 0xFFFFFFF6 invalid marker.
+	RecordInvalid
+	// RecordPresent means the station returned a value.
+	RecordPresent
+)
+
+func (s RecordState) String() string {
+	switch s {
+	case RecordUnsupported:
+		return "unsupported"
+	case RecordInvalid:
+		return "invalid"
+	case RecordPresent:
+		return "present"
+	}
+
+	return fmt.Sprintf("RecordState(%d)", int(s))
+}
+
 // Info TODO
 type Info struct {
 	records map[string]*InfoRecord
+	states  map[string]RecordState
+	// repeated holds the records after the first for tags that occur more
+	// than once, such as table rows.
+	repeated map[string][]*InfoRecord
 }
 
-// NewInfo TODO
-func NewInfo(retrievedBytes []byte) *Info {
-	info := &Info{
-		records: GetAllInfoRecords(),
+// newInfo returns an Info without any records.
+func newInfo() *Info {
+	return &Info{
+		records:  make(map[string]*InfoRecord),
+		states:   make(map[string]RecordState),
+		repeated: make(map[string][]*InfoRecord),
 	}
+}
+
+// DefaultMaxRecordSize is the largest record value ParseInfo accepts unless
+// told otherwise with WithMaxRecordSize.
+const DefaultMaxRecordSize = 4096
+
+// ParseOption configures ParseInfo.
+type ParseOption func(*parseOptions)
 
-	if 0 == len(retrievedBytes) {
-		return info
+type parseOptions struct {
+	strict        bool
+	maxRecordSize int
+}
+
+// WithMaxRecordSize limits the size of a single record value.
+func WithMaxRecordSize(size int) ParseOption {
+	return func(o *parseOptions) {
+		o.maxRecordSize = size
 	}
+}
+
+// NewInfo parses retrievedBytes leniently: it accepts any tag, encryption flag
+// and record size the data contains. On malformed data it returns the records
+// parsed so far together with the error.
+func NewInfo(retrievedBytes []byte) (*Info, error) {
+	return parseInfo(retrievedBytes, parseOptions{})
+}
+
+// ParseInfo parses a station response, validating the tag, encryption and
+// length of every record. Errors about a malformed record are *ParseError
+// values carrying the record offset.
+func ParseInfo(data []byte, opts ...ParseOption) (*Info, error) {
+	options := parseOptions{
+		strict:        true,
+		maxRecordSize: DefaultMaxRecordSize,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	info, err := parseInfo(data, options)
+	if nil != err {
+		return nil, err
+	}
+
+	return info, nil
+}
 
-	invalidBytes := []byte{0xFF, 0xFF, 0xFF, 0xF6}
+func parseInfo(retrievedBytes []byte, options parseOptions) (*Info, error) {
+	info := &Info{
+		records:  GetAllInfoRecords(),
+		states:   make(map[string]RecordState),
+		repeated: make(map[string][]*InfoRecord),
+	}
 
 	byteReader := bytes.NewReader(retrievedBytes)
 
 	for byteReader.Len() > 0 {
+		offset := byteReader.Size() - int64(byteReader.Len())
+
 		// read the tag
-		tagBytes := make([]byte, 4)
-		byteReader.Read(tagBytes)
+		tagBytes, err := info.readBytes(byteReader, 4)
+		if nil != err {
+			return info, &ParseError{Offset: offset, Err: err}
+		}
 		// Convert to string
 		tag := string(tagBytes[:])
 
-		if tag == "" {
+		//read the encryption
+		encryptionBytes, err := info.readBytes(byteReader, 4)
+		if nil != err {
+			return info, &ParseError{Offset: offset, Tag: tag, Err: err}
+		}
+		encryption := RecordEncryption(info.GetIntegerValue(encryptionBytes))
+
+		//read the length
+		lengthBytes, err := info.readBytes(byteReader, 4)
+		if nil != err {
+			return info, &ParseError{Offset: offset, Tag: tag, Err: err}
+		}
+		length := info.GetIntegerValue(lengthBytes)
+
+		// an all-zero record header terminates the list
+		if bytes.Equal(tagBytes, make([]byte, 4)) && 0 == encryption && 0 == length {
 			break
 		}
 
+		if options.strict {
+			err = info.validateRecord(tagBytes, encryption, length, options)
+			if nil != err {
+				return info, &ParseError{Offset: offset, Tag: tag, Err: err}
+			}
+		}
+
+		//read the value
+		valueBytes, err := info.readBytes(byteReader, int(length))
+		if nil != err {
+			return info, &ParseError{Offset: offset, Tag: tag, Err: err}
+		}
+
 		// get the corresponding element
 		element := info.Get(tag)
 
-		// check to make sure the element's not null, in case have received
-		// unknown tag: just ignore if null
-		if nil != element {
-			//read the encryption
-			encryptionBytes := make([]byte, 4)
-			byteReader.Read(encryptionBytes)
-			element.Encryption = RecordEncryption(info.GetIntegerValue(encryptionBytes))
-
-			//read the length
-			lengthBytes := make([]byte, 4)
-			byteReader.Read(lengthBytes)
-			length := info.GetIntegerValue(lengthBytes)
-
-			//read the value
-			valueBytes := make([]byte, length)
-			byteReader.Read(valueBytes)
-
-			if element.Encryption == EncryptionEncrypted {
-				valueBytes = DecryptBytes(CipherBytes, valueBytes)
+		// a tag returned more than once gets a fresh record for every
+		// repetition
+		repeated := RecordUnsupported != info.State(tag)
+		if repeated {
+			element = &InfoRecord{
+				Tag:         tag,
+				Description: element.Description,
+				DataType:    element.DataType,
+				MaxLength:   element.MaxLength,
 			}
+		}
 
-			// check if the value being sent is 0xFFFFFF6; this indicates
-			// the current value is invalid - just leave as 0. Ignore for
-			// IP addresses, though...
-			if bytes.Compare(valueBytes, invalidBytes) != 0 || element.DataType == TypeIPAddress {
-				element.Value = valueBytes
-			}
-		} else {
-			// just add an entry in hashtable
-			element := &InfoRecord{}
-
-			// assign the tag
-			element.Tag = tag
-			//read the encryption
-			encryptionBytes := make([]byte, 4)
-			byteReader.Read(encryptionBytes)
-			element.Encryption = RecordEncryption(info.GetIntegerValue(encryptionBytes))
-			//read the length
-			lengthBytes := make([]byte, 4)
-			byteReader.Read(lengthBytes)
-			length := info.GetIntegerValue(lengthBytes)
-			element.MaxLength = length
-
-			//read the value
-			valueBytes := make([]byte, length)
-			byteReader.Read(valueBytes)
-
-			if element.Encryption == EncryptionEncrypted {
-				valueBytes = DecryptBytes(CipherBytes, valueBytes)
+		// check to make sure the element's not null, in case have received
+		// unknown tag: just add an entry in hashtable
+		known := nil != element
+		if !known {
+			element = &InfoRecord{
+				Tag:       tag,
+				MaxLength: length,
 			}
+		}
+		element.Encryption = encryption
+
+		if element.Encryption == EncryptionEncrypted {
+			valueBytes = DecryptBytes(CipherBytes, valueBytes)
+		}
 
-			// check if the value being sent is 0xFFFFFF6; this indicates
-			// the current value is invalid - just leave as 0. Ignore for
-			// IP addresses, though...
-			if bytes.Compare(valueBytes, invalidBytes) != 0 || element.DataType == TypeIPAddress {
+		// check if the value being sent is 0xFFFFFF6; this indicates
+		// the current value is invalid - just leave as 0. Ignore for
+		// IP addresses, though...
+		state := RecordPresent
+		if bytes.Compare(valueBytes, invalidBytes) != 0 || element.DataType == TypeIPAddress {
+			element.Value = valueBytes
+		} else {
+			state = RecordInvalid
+			switch {
+			case repeated:
+				// later rows have no state of their own, keep the marker
+				// so that the row does not pass for an empty one
 				element.Value = valueBytes
-			} else {
+			case !known:
 				element.Value = make([]byte, element.MaxLength)
 			}
+		}
 
-			// add the element
-			info.Put(tag, element)
+		// add the element
+		if repeated {
+			info.Add(tag, element)
+		} else {
+			info.put(tag, element, state)
 		}
 	}
-	return info
+	return info, nil
+}
+
+// validateRecord checks a record header before its value is read.
+func (i *Info) validateRecord(tagBytes []byte, encryption RecordEncryption, length int32, options parseOptions) error {
+	for _, b := range tagBytes {
+		if b < 0x20 || b > 0x7e {
+			return fmt.Errorf("%w: tag %q is not printable", ErrMalformedRecord, tagBytes)
+		}
+	}
+
+	if EncryptionUnencrypted != encryption && EncryptionEncrypted != encryption {
+		return fmt.Errorf("%w: unknown encryption %d", ErrMalformedRecord, encryption)
+	}
+
+	if 0 > length {
+		return fmt.Errorf("%w: negative length %d", ErrMalformedRecord, length)
+	}
+
+	if 0 < options.maxRecordSize && int(length) > options.maxRecordSize {
+		return fmt.Errorf("%w: length %d exceeds maximum of %d", ErrMalformedRecord, length, options.maxRecordSize)
+	}
+
+	return nil
+}
+
+// readBytes reads exactly n bytes from byteReader.
+func (i *Info) readBytes(byteReader *bytes.Reader, n int) ([]byte, error) {
+	if 0 > n || byteReader.Len() < n {
+		return nil, fmt.Errorf("%w: need %d bytes, have %d", ErrTruncatedResponse, n, byteReader.Len())
+	}
+
+	valueBytes := make([]byte, n)
+	byteReader.Read(valueBytes)
+
+	return valueBytes, nil
 }
 
 // GetUpdateBytes TODO
 func (i *Info) GetUpdateBytes() []byte {
 	var arr []byte
-	for _, element := range i.records {
+	for tag, element := range i.records {
 		arr = append(arr, element.GetUpdateBytes()...)
+		for _, repeated := range i.repeated[tag] {
+			arr = append(arr, repeated.GetUpdateBytes()...)
+		}
 	}
 	return arr
 }
@@ -133,7 +274,71 @@ func (i *Info) GetIntegerValue(valueBytes []byte) int32 {
 
 // Put TODO
 func (i *Info) Put(tag string, record *InfoRecord) {
+	i.put(tag, record, RecordPresent)
+}
+
+func (i *Info) put(tag string, record *InfoRecord, state RecordState) {
 	i.records[tag] = record
+	i.states[tag] = state
+	delete(i.repeated, tag)
+}
+
+// Add appends another record for tag, for tags that occur more than once.
+// Get keeps returning the first record; GetAll returns all of them.
+func (i *Info) Add(tag string, record *InfoRecord) {
+	if RecordUnsupported == i.State(tag) {
+		i.Put(tag, record)
+		return
+	}
+
+	i.repeated[tag] = append(i.repeated[tag], record)
+}
+
+// newTableRecords returns one record for tag per row of a table. An empty
+// table is a single empty record, so that writing it clears the table.
+func newTableRecords(tag string, rows [][]byte) ([]*InfoRecord, error) {
+	if 0 == len(rows) {
+		return []*InfoRecord{lookupInfoRecord(tag)}, nil
+	}
+
+	records := make([]*InfoRecord, 0, len(rows))
+	for _, row := range rows {
+		infoRecord := lookupInfoRecord(tag)
+		if err := infoRecord.SetBytes(row); nil != err {
+			return nil, err
+		}
+		records = append(records, infoRecord)
+	}
+
+	return records, nil
+}
+
+// GetAll returns every record for tag in the order they were returned or
+// added, or nil when there is none. State only covers the first record;
+// later rows the station marked invalid hold the marker 0xFFFFFFF6.
+func (i *Info) GetAll(tag string) []*InfoRecord {
+	if RecordUnsupported == i.State(tag) {
+		return nil
+	}
+
+	return append([]*InfoRecord{i.records[tag]}, i.repeated[tag]...)
+}
+
+// State returns whether the station returned tag, and whether its value was
+// valid. Records that were never returned are RecordUnsupported.
+func (i *Info) State(tag string) RecordState {
+	return i.states[tag]
+}
+
+// Tags returns the tags of all records, sorted.
+func (i *Info) Tags() []string {
+	tags := make([]string, 0, len(i.records))
+	for tag := range i.records {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	return tags
 }
 
 // Get TODO
@@ -4,8 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/hex"
-	"net"
-	"strconv"
+	"fmt"
 )
 
 // RecordType TODO
@@ -68,6 +67,10 @@ type InfoRecord struct {
 	Encryption  RecordEncryption
 	MaxLength   int32
 	Value       []byte
+	// ReadOnly marks tags that can be read but never written.
+	ReadOnly bool
+	// Secret marks tags whose values must not be shown to users.
+	Secret bool
 }
 
 // NewInfoRecord TODO
@@ -90,32 +93,6 @@ func NewInfoRecord(tag string, description string, dataType RecordType, encrypti
 	return airportInfoRecord
 }
 
-func (i *InfoRecord) convertToUnsignedInteger(bytes []byte) uint32 {
-	return binary.BigEndian.Uint32(bytes)
-}
-
-func (i *InfoRecord) convertToIPAddress(bytes []byte) string {
-	returnString := ""
-	value := 0
-
-	for j := 0; j < len(bytes)-1; j++ {
-		value = int(bytes[j])
-		if value < 0 {
-			value += 256
-		}
-		returnString += strconv.Itoa(value) + "."
-	}
-
-	value = int(bytes[len(bytes)-1])
-	if value < 0 {
-		value += 256
-	}
-
-	returnString += strconv.Itoa(value)
-
-	return returnString
-}
-
 // GetValue TODO
 func (i *InfoRecord) GetValue() []byte {
 	return i.Value
@@ -181,95 +158,85 @@ func (i *InfoRecord) hexBytes(bytes []byte) string {
 	return hex.EncodeToString(bytes)
 }
 
-// SetBytesFromString TODO
-func (i *InfoRecord) SetBytesFromString(value string) {
-	var bytes []byte
-
-	switch i.DataType {
-	case TypeUnsignedInteger:
-		bytes = i.convertFromUnsignedInteger(value)
-		break
-	case TypeLittleEndianUnsignedInteger:
-		bytes = i.convertFromUnsignedInteger(value)
-		bytes = i.reverseBytes(bytes)
-		break
-	case TypeCharString:
-		fallthrough
-	case TypePhoneNumber:
-		if int32(len(value)) > i.MaxLength-1 {
-			panic("Maximum " + string((i.MaxLength - 1)) + " characters.")
-		}
-		// Convert string to bytes.
-		bytes = []byte(value)
-		break
-	case TypeIPAddress:
-		bytes = i.convertFromIPv4Address(value)
-		break
-	case TypeByte:
-		fallthrough
-	case TypeByteString:
-		fallthrough
-	default:
-		bytes = i.convertFromHexString(value)
-		break
+// SetBytesFromString sets the value from its text form, as returned by
+// String. The value is validated against DataType and MaxLength.
+func (i *InfoRecord) SetBytesFromString(value string) error {
+	bytes, err := i.parseString(value)
+	if nil != err {
+		return err
 	}
 
 	i.Value = bytes
+	return nil
 }
 
-func (i *InfoRecord) convertFromIPv4Address(address string) []byte {
-	return net.ParseIP(address)
+// parseString converts value into the record's wire representation,
+// validating it against DataType and MaxLength.
+func (i *InfoRecord) parseString(value string) ([]byte, error) {
+	if TypeCharString == i.DataType || TypePhoneNumber == i.DataType {
+		if maxLength := i.maxTextLength(); 0 < maxLength && int32(len(value)) > maxLength {
+			return nil, fmt.Errorf("%w: %s: maximum %d characters", ErrInvalidValue, i.Tag, maxLength)
+		}
+	}
+
+	bytes, err := i.codec().Encode(value)
+	if nil != err {
+		return nil, fmt.Errorf("%s: %w", i.Tag, err)
+	}
+
+	if 0 < i.MaxLength && int32(len(bytes)) > i.MaxLength {
+		return nil, fmt.Errorf("%w: %s: value is %d bytes, maximum %d", ErrInvalidValue, i.Tag, len(bytes), i.MaxLength)
+	}
+
+	return bytes, nil
 }
 
-func (i *InfoRecord) convertFromUnsignedInteger(value string) []byte {
-	buf := new(bytes.Buffer)
-	parsed, _ := strconv.ParseUint(value, 10, 32)
-	binary.Write(buf, binary.LittleEndian, parsed)
-	return buf.Bytes()
+// maxTextLength returns how many characters a char string may hold. Values
+// written to the station need room for a terminating NUL; read-only values
+// such as the 40 character build hash fill the whole record.
+func (i *InfoRecord) maxTextLength() int32 {
+	if i.ReadOnly || 0 >= i.MaxLength {
+		return i.MaxLength
+	}
+
+	return i.MaxLength - 1
 }
 
-func (i *InfoRecord) convertFromHexString(hexVal string) []byte {
-	decoded, _ := hex.DecodeString(hexVal)
-	return decoded
+func (i *InfoRecord) String() string {
+	returnString, _ := i.text()
+
+	return returnString
 }
 
-func (i *InfoRecord) reverseBytes(inBytes []byte) []byte {
-	// From http://stackoverflow.com/a/19239850/1107285
-	for i, j := 0, len(inBytes)-1; i < j; i, j = i+1, j-1 {
-		inBytes[i], inBytes[j] = inBytes[j], inBytes[i]
+// text returns the value decoded like String, and whether it is the raw hex
+// form instead because the value does not decode.
+func (i *InfoRecord) text() (string, bool) {
+	returnString, err := i.codec().Decode(i.Value)
+	if nil != err {
+		// malformed values are still worth showing
+		return i.hexBytes(i.Value), true
 	}
 
-	return inBytes
+	return returnString, false
 }
 
-func (i *InfoRecord) String() string {
-	returnString := ""
-	bytes := i.Value
-
-	switch i.DataType {
-	case TypeUnsignedInteger:
-		returnString = string(i.convertToUnsignedInteger(bytes))
-		break
-	case TypeLittleEndianUnsignedInteger:
-		bytes = i.reverseBytes(bytes)
-
-		returnString = string(i.convertToUnsignedInteger(bytes))
-		break
-	case TypeCharString:
-	case TypePhoneNumber:
-		returnString = string(bytes[:])
-		break
-	case TypeIPAddress:
-		returnString = i.convertToIPAddress(bytes)
-		break
-	case TypeByte:
-	case TypeByteString:
-	default:
-		returnString = i.hexBytes(bytes)
-		break
+// parseText is the reverse of text: it parses a decoded value like
+// parseString, or a raw hex value as is.
+func (i *InfoRecord) parseText(value string, raw bool) ([]byte, error) {
+	if !raw {
+		return i.parseString(value)
 	}
 
-	return returnString
+	bytes, err := hexCodec{}.Encode(value)
+	if nil != err {
+		return nil, fmt.Errorf("%s: %w", i.Tag, err)
+	}
+
+	if 0 < i.MaxLength && int32(len(bytes)) > i.MaxLength {
+		return nil, fmt.Errorf("%w: %s: value is %d bytes, maximum %d", ErrInvalidValue, i.Tag, len(bytes), i.MaxLength)
+	}
+
+	return bytes, nil
 }
 
 // EncryptBytes TODO
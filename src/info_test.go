@@ -0,0 +1,145 @@
+package airport_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+// rawRecord encodes a record the way a station sends it, without encrypting
+// the value.
+func rawRecord(tag string, encryption uint32, value []byte) []byte {
+	data := make([]byte, 12, 12+len(value))
+	copy(data, tag)
+	binary.BigEndian.PutUint32(data[4:8], encryption)
+	binary.BigEndian.PutUint32(data[8:12], uint32(len(value)))
+
+	return append(data, value...)
+}
+
+func TestParseInfo(t *testing.T) {
+	data := bytes.Join([][]byte{
+		rawRecord("raCh", 0, []byte{0, 0, 0, 6}),
+		rawRecord("raNA", 0, []byte{0xFF, 0xFF, 0xFF, 0xF6}),
+		rawRecord("acTa", 0, []byte("first")),
+		rawRecord("acTa", 0, []byte("second")),
+		make([]byte, 12),
+		rawRecord("syNm", 0, []byte("after the end")),
+	}, nil)
+
+	info, err := airport.ParseInfo(data)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if got := info.Get("raCh").String(); "6" != got {
+		t.Errorf("raCh = %q, want 6", got)
+	}
+
+	if airport.RecordInvalid != info.State("raNA") {
+		t.Errorf("raNA state = %v, want invalid", info.State("raNA"))
+	}
+
+	if rows := info.GetAll("acTa"); 2 != len(rows) || "second" != string(rows[1].Value) {
+		t.Errorf("acTa rows = %v, want first and second", rows)
+	}
+
+	if airport.RecordUnsupported != info.State("syNm") {
+		t.Errorf("syNm after the terminator was parsed")
+	}
+}
+
+func TestParseInfoInvalidRow(t *testing.T) {
+	marker := []byte{0xFF, 0xFF, 0xFF, 0xF6}
+	data := bytes.Join([][]byte{
+		rawRecord("acTa", 0, []byte("first")),
+		rawRecord("acTa", 0, marker),
+	}, nil)
+
+	info, err := airport.ParseInfo(data)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	// the state covers the first row, the invalid second row keeps the marker
+	if airport.RecordPresent != info.State("acTa") {
+		t.Errorf("acTa state = %v, want present", info.State("acTa"))
+	}
+	if rows := info.GetAll("acTa"); 2 != len(rows) || !bytes.Equal(marker, rows[1].Value) {
+		t.Errorf("acTa rows = %v, want first and the invalid marker", rows)
+	}
+
+	// also after decrypting
+	info, err = airport.ParseInfo(bytes.Join([][]byte{
+		rawRecord("acTa", 2, airport.EncryptBytes(airport.CipherBytes, []byte("first"))),
+		rawRecord("acTa", 2, airport.EncryptBytes(airport.CipherBytes, marker)),
+	}, nil))
+	if nil != err {
+		t.Fatal(err)
+	}
+	if rows := info.GetAll("acTa"); 2 != len(rows) || !bytes.Equal(marker, rows[1].Value) {
+		t.Errorf("encrypted acTa rows = %v, want first and the invalid marker", rows)
+	}
+}
+
+func TestParseInfoErrors(t *testing.T) {
+	valid := rawRecord("syNm", 0, []byte("Office"))
+	offset := int64(len(valid))
+
+	tests := []struct {
+		name string
+		data []byte
+		tag  string
+		err  error
+	}{
+		{"truncated tag", []byte("sy"), "", airport.ErrTruncatedResponse},
+		{"truncated length", []byte("syNm\x00\x00\x00\x00\x00"), "syNm", airport.ErrTruncatedResponse},
+		{"truncated value", rawRecord("syNm", 0, []byte("Office"))[:15], "syNm", airport.ErrTruncatedResponse},
+		{"unprintable tag", rawRecord("sy\x01m", 0, nil), "sy\x01m", airport.ErrMalformedRecord},
+		{"encryption", rawRecord("syNm", 7, nil), "syNm", airport.ErrMalformedRecord},
+		{"negative length", []byte("syNm\x00\x00\x00\x00\xFF\xFF\xFF\xFF"), "syNm", airport.ErrMalformedRecord},
+		{"oversized", rawRecord("syNm", 0, make([]byte, airport.DefaultMaxRecordSize+1)), "syNm", airport.ErrMalformedRecord},
+	}
+
+	for _, test := range tests {
+		_, err := airport.ParseInfo(append(append([]byte(nil), valid...), test.data...))
+
+		var parseErr *airport.ParseError
+		if !errors.As(err, &parseErr) || !errors.Is(err, test.err) {
+			t.Errorf("%s: ParseInfo() error = %v, want ParseError wrapping %v", test.name, err, test.err)
+			continue
+		}
+
+		if offset != parseErr.Offset || test.tag != parseErr.Tag {
+			t.Errorf("%s: error at %d tag %q, want %d tag %q", test.name, parseErr.Offset, parseErr.Tag, offset, test.tag)
+		}
+	}
+}
+
+func TestParseInfoMaxRecordSize(t *testing.T) {
+	data := rawRecord("syNm", 0, []byte("Office"))
+
+	if _, err := airport.ParseInfo(data, airport.WithMaxRecordSize(4)); !errors.Is(err, airport.ErrMalformedRecord) {
+		t.Errorf("ParseInfo() error = %v, want ErrMalformedRecord", err)
+	}
+
+	if _, err := airport.ParseInfo(data, airport.WithMaxRecordSize(6)); nil != err {
+		t.Errorf("ParseInfo() error = %v", err)
+	}
+}
+
+func TestNewInfoLenient(t *testing.T) {
+	data := append(rawRecord("zzzz", 7, []byte("odd")), rawRecord("syNm", 0, []byte("Office"))[:14]...)
+
+	info, err := airport.NewInfo(data)
+	if !errors.Is(err, airport.ErrTruncatedResponse) {
+		t.Errorf("NewInfo() error = %v, want ErrTruncatedResponse", err)
+	}
+
+	if nil == info || "odd" != string(info.Get("zzzz").Value) {
+		t.Errorf("NewInfo() did not return the records parsed before the error")
+	}
+}
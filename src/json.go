@@ -0,0 +1,214 @@
+package airport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// infoRecordJSON is the JSON form of an InfoRecord.
+type infoRecordJSON struct {
+	Tag         string          `json:"tag"`
+	Description string          `json:"description,omitempty"`
+	DataType    string          `json:"dataType"`
+	Encryption  string          `json:"encryption"`
+	MaxLength   int32           `json:"maxLength"`
+	Value       json.RawMessage `json:"value"`
+	// Raw marks values that do not decode, which are kept as hex.
+	Raw bool `json:"raw,omitempty"`
+	// Invalid marks records holding the invalid marker.
+	Invalid bool `json:"invalid,omitempty"`
+}
+
+// MarshalJSON encodes the record with its value in decoded form: a number
+// for unsigned integers, a string for text and IP addresses and a hex string
+// for everything else. Empty values are null. Values that do not decode are
+// written as hex and marked raw, like String shows them.
+func (i *InfoRecord) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.recordJSON())
+}
+
+// UnmarshalJSON decodes a record encoded by MarshalJSON. Registered tags take
+// their metadata from the registry, and the value is validated against it.
+func (i *InfoRecord) UnmarshalJSON(data []byte) error {
+	var decoded infoRecordJSON
+	if err := json.Unmarshal(data, &decoded); nil != err {
+		return err
+	}
+
+	infoRecord, err := decoded.infoRecord()
+	if nil != err {
+		return err
+	}
+
+	*i = *infoRecord
+	return nil
+}
+
+func (i *InfoRecord) recordJSON() infoRecordJSON {
+	encoded := infoRecordJSON{
+		Tag:         i.Tag,
+		Description: i.Description,
+		DataType:    i.DataType.String(),
+		Encryption:  i.Encryption.String(),
+		MaxLength:   i.MaxLength,
+		Value:       json.RawMessage("null"),
+	}
+
+	if 0 == len(i.Value) {
+		return encoded
+	}
+
+	text, raw := i.text()
+	encoded.Raw = raw
+
+	switch i.DataType {
+	case TypeUnsignedInteger, TypeLittleEndianUnsignedInteger:
+		if !raw {
+			encoded.Value = json.RawMessage(text)
+			return encoded
+		}
+	}
+
+	encoded.Value, _ = json.Marshal(text)
+	return encoded
+}
+
+// infoRecord validates the decoded JSON and converts it to a record. A null
+// value leaves the record empty.
+func (d *infoRecordJSON) infoRecord() (*InfoRecord, error) {
+	if 4 != len(d.Tag) {
+		return nil, fmt.Errorf("%w: tag %q is not 4 characters long", ErrMalformedRecord, d.Tag)
+	}
+
+	dataType, err := parseRecordType(d.DataType)
+	if nil != err {
+		return nil, err
+	}
+
+	encryption, err := parseRecordEncryption(d.Encryption)
+	if nil != err {
+		return nil, err
+	}
+
+	infoRecord := lookupInfoRecord(d.Tag)
+	if nil == infoRecord {
+		infoRecord = NewInfoRecord(d.Tag, d.Description, dataType, encryption, d.MaxLength, make([]byte, 0))
+	} else if dataType != infoRecord.DataType || encryption != infoRecord.Encryption || d.MaxLength != infoRecord.MaxLength {
+		return nil, fmt.Errorf("%w: %s is %s/%s/%d, registry has %s/%s/%d", ErrMalformedRecord, d.Tag,
+			dataType, encryption, d.MaxLength, infoRecord.DataType, infoRecord.Encryption, infoRecord.MaxLength)
+	}
+
+	if 0 == len(d.Value) || bytes.Equal(d.Value, []byte("null")) {
+		return infoRecord, nil
+	}
+
+	var text string
+	switch infoRecord.DataType {
+	case TypeUnsignedInteger, TypeLittleEndianUnsignedInteger:
+		if d.Raw {
+			err = json.Unmarshal(d.Value, &text)
+			break
+		}
+
+		var number json.Number
+		err = json.Unmarshal(d.Value, &number)
+		text = number.String()
+	default:
+		err = json.Unmarshal(d.Value, &text)
+	}
+	if nil != err {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidValue, d.Tag, err)
+	}
+
+	value, err := infoRecord.parseText(text, d.Raw)
+	if nil != err {
+		return nil, err
+	}
+
+	infoRecord.SetValue(value)
+	return infoRecord, nil
+}
+
+// MarshalJSON encodes the records the station returned as an object keyed by
+// tag. Tags with more than one record, such as table rows, map to an array
+// of records. Records holding the invalid marker have a null value and are
+// marked invalid.
+func (i *Info) MarshalJSON() ([]byte, error) {
+	records := make(map[string]any)
+
+	for _, tag := range i.Tags() {
+		switch i.State(tag) {
+		case RecordUnsupported:
+			continue
+		case RecordInvalid:
+			encoded := i.Get(tag).recordJSON()
+			encoded.Value = json.RawMessage("null")
+			encoded.Raw = false
+			encoded.Invalid = true
+			records[tag] = encoded
+			continue
+		}
+
+		rows := i.GetAll(tag)
+		if 1 == len(rows) {
+			records[tag] = rows[0].recordJSON()
+			continue
+		}
+
+		encoded := make([]infoRecordJSON, 0, len(rows))
+		for _, row := range rows {
+			encoded = append(encoded, row.recordJSON())
+		}
+		records[tag] = encoded
+	}
+
+	return json.Marshal(records)
+}
+
+// UnmarshalJSON decodes records encoded by MarshalJSON, validating each of
+// them like InfoRecord.UnmarshalJSON does.
+func (i *Info) UnmarshalJSON(data []byte) error {
+	var records map[string]json.RawMessage
+	if err := json.Unmarshal(data, &records); nil != err {
+		return err
+	}
+
+	info := newInfo()
+	for tag, encoded := range records {
+		var rows []infoRecordJSON
+		if bytes.HasPrefix(bytes.TrimSpace(encoded), []byte("[")) {
+			if err := json.Unmarshal(encoded, &rows); nil != err {
+				return err
+			}
+		} else {
+			rows = make([]infoRecordJSON, 1)
+			if err := json.Unmarshal(encoded, &rows[0]); nil != err {
+				return err
+			}
+		}
+
+		for _, decoded := range rows {
+			if "" == decoded.Tag {
+				decoded.Tag = tag
+			}
+			if tag != decoded.Tag {
+				return fmt.Errorf("%w: record %q stored under %q", ErrMalformedRecord, decoded.Tag, tag)
+			}
+
+			infoRecord, err := decoded.infoRecord()
+			if nil != err {
+				return err
+			}
+
+			if decoded.Invalid {
+				info.put(tag, infoRecord, RecordInvalid)
+				continue
+			}
+			info.Add(tag, infoRecord)
+		}
+	}
+
+	*i = *info
+	return nil
+}
@@ -0,0 +1,127 @@
+package airport_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+func TestInfoRecordJSON(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value []byte
+		json  string
+	}{
+		{"raCh", []byte{0, 0, 0, 6}, `"value":6`},
+		{"raCh", nil, `"value":null`},
+		{"raCh", []byte{1}, `"value":"01","raw":true`},
+		{"laIP", []byte{10, 0, 1, 1}, `"value":"10.0.1.1"`},
+		{"laIP", []byte{10, 0, 1}, `"value":"0a0001","raw":true`},
+		{"syNm", []byte("Office\x00\x00"), `"value":"Office"`},
+		{"raWM", []byte{0, 0, 0, 2}, `"value":"wep128"`},
+	}
+
+	for _, test := range tests {
+		infoRecord := airport.GetInfoRecord(test.tag)
+		infoRecord.SetValue(test.value)
+
+		data, err := json.Marshal(infoRecord)
+		if nil != err {
+			t.Errorf("%s %x: Marshal() error = %v", test.tag, test.value, err)
+			continue
+		}
+
+		if !strings.Contains(string(data), test.json) {
+			t.Errorf("%s %x: Marshal() = %s, want %s", test.tag, test.value, data, test.json)
+		}
+
+		var decoded airport.InfoRecord
+		if err = json.Unmarshal(data, &decoded); nil != err {
+			t.Errorf("%s %x: Unmarshal(%s) error = %v", test.tag, test.value, data, err)
+			continue
+		}
+
+		want := bytes.TrimRight(test.value, "\x00")
+		if !bytes.Equal(want, decoded.Value) {
+			t.Errorf("%s: Unmarshal(%s) = %x, want %x", test.tag, data, decoded.Value, want)
+		}
+	}
+}
+
+func TestInfoRecordJSONInvalid(t *testing.T) {
+	for _, data := range []string{
+		`{"tag":"raCh","dataType":"TypeUnsignedInteger","encryption":"EncryptionUnencrypted","maxLength":4,"value":"x"}`,
+		`{"tag":"raCh","dataType":"TypeUnsignedInteger","encryption":"EncryptionUnencrypted","maxLength":4,"value":"0102030405","raw":true}`,
+		`{"tag":"raCh","dataType":"TypeCharString","encryption":"EncryptionUnencrypted","maxLength":4,"value":"x"}`,
+		`{"tag":"raChx","dataType":"TypeUnsignedInteger","encryption":"EncryptionUnencrypted","maxLength":4,"value":1}`,
+	} {
+		var decoded airport.InfoRecord
+		if err := json.Unmarshal([]byte(data), &decoded); nil == err {
+			t.Errorf("Unmarshal(%s) succeeded", data)
+		}
+	}
+}
+
+func TestInfoJSON(t *testing.T) {
+	info := parseRecords(t,
+		rawRecord("buil", 0, []byte("7.6.8")),
+		rawRecord("raCh", 0, []byte{1}),
+		rawRecord("moID", 0, nil),
+		rawRecord("raNA", 0, []byte{0xFF, 0xFF, 0xFF, 0xF6}),
+	)
+
+	data, err := json.Marshal(info)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	var decoded airport.Info
+	if err = json.Unmarshal(data, &decoded); nil != err {
+		t.Fatalf("Unmarshal(%s): %v", data, err)
+	}
+
+	if changes := airport.Diff(info, &decoded); 0 != len(changes) {
+		t.Errorf("JSON round trip changed %v", changes)
+	}
+
+	if airport.RecordInvalid != decoded.State("raNA") || airport.RecordPresent != decoded.State("moID") {
+		t.Errorf("JSON round trip states raNA %v moID %v, want invalid and present", decoded.State("raNA"), decoded.State("moID"))
+	}
+}
+
+func TestInfoJSONTables(t *testing.T) {
+	info := parseRecords(t,
+		rawRecord("pmTa", 2, []byte{1}),
+		rawRecord("pmTa", 2, []byte{2}),
+		rawRecord("pmTa", 2, []byte{3}),
+		rawRecord("buil", 0, []byte("7.6.8")),
+	)
+
+	data, err := json.Marshal(info)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	var encoded map[string]json.RawMessage
+	if err = json.Unmarshal(data, &encoded); nil != err {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(encoded["pmTa"], []byte("[")) || bytes.HasPrefix(encoded["buil"], []byte("[")) {
+		t.Errorf("Marshal() = %s, want an array for pmTa only", data)
+	}
+
+	var decoded airport.Info
+	if err = json.Unmarshal(data, &decoded); nil != err {
+		t.Fatal(err)
+	}
+
+	if rows := decoded.GetAll("pmTa"); 3 != len(rows) {
+		t.Errorf("JSON round trip kept %d pmTa rows, want 3", len(rows))
+	}
+	if changes := airport.Diff(info, &decoded); 0 != len(changes) {
+		t.Errorf("JSON round trip changed %v", changes)
+	}
+}
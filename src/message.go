@@ -3,6 +3,7 @@ package airport
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"hash/adler32"
 )
 
@@ -13,11 +14,14 @@ const (
 	MessageTypeWrite = 0x15
 )
 
+// MessageSize is the length of an encoded message header.
+const MessageSize = 128
+
 var (
 	messageTag    = []byte("acpp")
 	unknownField1 = []byte{0, 0, 0, 1}
 	unknownField2 = make([]byte, 8)
-	unknownField3 = make([]byte, 16)
+	unknownField3 = make([]byte, 12)
 	unknownField4 = make([]byte, 48)
 )
 
@@ -26,6 +30,7 @@ type Message struct {
 	payloadSize     int32
 	payloadChecksum uint32
 	messageType     int32
+	status          int32
 	password        []byte
 	messageChecksum uint32
 }
@@ -55,6 +60,40 @@ func NewMessage(messageType int, password string, payloadBytes []byte, payloadSi
 	return airportMessage
 }
 
+// ParseMessage decodes a message header as produced by GetBytes. It checks
+// the magic number and the header checksum; the payload checksum can only be
+// checked once the payload has been read, see VerifyPayload.
+func ParseMessage(messageBytes []byte) (*Message, error) {
+	if len(messageBytes) < MessageSize {
+		return nil, fmt.Errorf("%w: message header is %d bytes, expected %d", ErrTruncatedResponse, len(messageBytes), MessageSize)
+	}
+
+	if !bytes.Equal(messageBytes[0:4], messageTag) {
+		return nil, fmt.Errorf("%w: magic is %q, expected %q", ErrInvalidMessage, messageBytes[0:4], messageTag)
+	}
+
+	airportMessage := &Message{
+		messageChecksum: binary.BigEndian.Uint32(messageBytes[8:12]),
+		payloadChecksum: binary.BigEndian.Uint32(messageBytes[12:16]),
+		payloadSize:     int32(binary.BigEndian.Uint32(messageBytes[16:20])),
+		messageType:     int32(binary.BigEndian.Uint32(messageBytes[28:32])),
+		status:          int32(binary.BigEndian.Uint32(messageBytes[32:36])),
+		password:        make([]byte, 32),
+	}
+	copy(airportMessage.password, messageBytes[48:80])
+
+	// the header checksum is computed with the checksum field zeroed
+	header := make([]byte, MessageSize)
+	copy(header, messageBytes)
+	copy(header[8:12], make([]byte, 4))
+
+	if checksum := airportMessage.computeChecksum(header); checksum != airportMessage.messageChecksum {
+		return nil, fmt.Errorf("%w: message header checksum is %#08x, expected %#08x", ErrChecksumMismatch, checksum, airportMessage.messageChecksum)
+	}
+
+	return airportMessage, nil
+}
+
 // GetBytes TODO
 func (m *Message) GetBytes() []byte {
 	buf := new(bytes.Buffer)
@@ -67,6 +106,7 @@ func (m *Message) GetBytes() []byte {
 	binary.Write(buf, binary.BigEndian, unknownField2)
 	// binary.Write(buf, binary.BigEndian, 0x00)
 	binary.Write(buf, binary.BigEndian, m.messageType)
+	binary.Write(buf, binary.BigEndian, m.status)
 	binary.Write(buf, binary.BigEndian, unknownField3)
 	binary.Write(buf, binary.BigEndian, m.password)
 	binary.Write(buf, binary.BigEndian, unknownField4)
@@ -80,6 +120,53 @@ func (m *Message) GetBytes() []byte {
 	return outStream
 }
 
+// SetStatus sets the status code reported to the peer and updates the header
+// checksum accordingly.
+func (m *Message) SetStatus(status int32) {
+	m.status = status
+	m.messageChecksum = 0
+	m.messageChecksum = m.computeChecksum(m.GetBytes())
+}
+
+// Password returns the decrypted password the message was sent with.
+func (m *Message) Password() string {
+	return string(bytes.TrimRight(DecryptBytes(CipherBytes, m.password), "\x00"))
+}
+
+// PayloadSize returns the announced payload length. Negative values mean the
+// payload runs until the connection is closed.
+func (m *Message) PayloadSize() int {
+	return int(m.payloadSize)
+}
+
+// MessageType returns the message type, MessageTypeRead or MessageTypeWrite.
+func (m *Message) MessageType() int {
+	return int(m.messageType)
+}
+
+// Status returns the status code set by the station. Zero means success.
+func (m *Message) Status() int32 {
+	return m.status
+}
+
+// VerifyPayload checks payloadBytes against the payload size and checksum
+// from the header. Payloads of unannounced size carry no checksum.
+func (m *Message) VerifyPayload(payloadBytes []byte) error {
+	if 0 > m.payloadSize {
+		return nil
+	}
+
+	if len(payloadBytes) != int(m.payloadSize) {
+		return fmt.Errorf("%w: payload is %d bytes, expected %d", ErrTruncatedResponse, len(payloadBytes), m.payloadSize)
+	}
+
+	if checksum := m.computeChecksum(payloadBytes); checksum != m.payloadChecksum {
+		return fmt.Errorf("%w: payload checksum is %#08x, expected %#08x", ErrChecksumMismatch, checksum, m.payloadChecksum)
+	}
+
+	return nil
+}
+
 func (m *Message) computeChecksum(fileBytes []byte) uint32 {
 	return adler32.Checksum(fileBytes)
 }
@@ -0,0 +1,79 @@
+package airport_test
+
+import (
+	"errors"
+	"testing"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+func TestParseMessage(t *testing.T) {
+	payload := []byte("syNm\x00\x00\x00\x00\x00\x00\x00\x00")
+	message := airport.NewMessage(airport.MessageTypeRead, "secret", payload, len(payload))
+
+	parsed, err := airport.ParseMessage(message.GetBytes())
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if airport.MessageTypeRead != parsed.MessageType() || len(payload) != parsed.PayloadSize() || 0 != parsed.Status() {
+		t.Errorf("ParseMessage() = type %#x size %d status %d", parsed.MessageType(), parsed.PayloadSize(), parsed.Status())
+	}
+
+	if "secret" != parsed.Password() {
+		t.Errorf("Password() = %q, want %q", parsed.Password(), "secret")
+	}
+
+	if err = parsed.VerifyPayload(payload); nil != err {
+		t.Errorf("VerifyPayload() = %v", err)
+	}
+
+	if err = parsed.VerifyPayload(payload[1:]); !errors.Is(err, airport.ErrTruncatedResponse) {
+		t.Errorf("VerifyPayload(short) = %v, want ErrTruncatedResponse", err)
+	}
+
+	corrupted := append([]byte("xyNm"), payload[4:]...)
+	if err = parsed.VerifyPayload(corrupted); !errors.Is(err, airport.ErrChecksumMismatch) {
+		t.Errorf("VerifyPayload(corrupted) = %v, want ErrChecksumMismatch", err)
+	}
+}
+
+func TestParseMessageErrors(t *testing.T) {
+	header := airport.NewMessage(airport.MessageTypeWrite, "secret", nil, 0).GetBytes()
+
+	badMagic := append([]byte(nil), header...)
+	copy(badMagic, "xcpp")
+
+	badChecksum := append([]byte(nil), header...)
+	badChecksum[40] ^= 0xFF
+
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"short", header[:airport.MessageSize-1], airport.ErrTruncatedResponse},
+		{"magic", badMagic, airport.ErrInvalidMessage},
+		{"checksum", badChecksum, airport.ErrChecksumMismatch},
+	}
+
+	for _, test := range tests {
+		if _, err := airport.ParseMessage(test.data); !errors.Is(err, test.err) {
+			t.Errorf("%s: ParseMessage() error = %v, want %v", test.name, err, test.err)
+		}
+	}
+}
+
+func TestMessageSetStatus(t *testing.T) {
+	message := airport.NewMessage(airport.MessageTypeRead, "", nil, 0)
+	message.SetStatus(airport.StatusAuthenticationFailed)
+
+	parsed, err := airport.ParseMessage(message.GetBytes())
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if airport.StatusAuthenticationFailed != parsed.Status() {
+		t.Errorf("Status() = %d, want %d", parsed.Status(), airport.StatusAuthenticationFailed)
+	}
+}
@@ -0,0 +1,55 @@
+package airport
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+// Dialer opens connections to stations. *net.Dialer implements it; other
+// implementations can tunnel through port forwards or proxies, or connect to
+// in-memory stations in tests. Writes end their payload by half-closing the
+// connection, so the connections must have a CloseWrite method, like
+// *net.TCPConn, or return a connection that has one from NetConn.
+type Dialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// Option configures an Airport created with New.
+type Option func(*Airport)
+
+// WithPassword sets the station password.
+func WithPassword(password string) Option {
+	return func(a *Airport) {
+		a.Password = password
+	}
+}
+
+// WithPort overrides the port, including one given in the address.
+func WithPort(port int) Option {
+	return func(a *Airport) {
+		a.Port = port
+	}
+}
+
+// WithTimeout sets Airport.Timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(a *Airport) {
+		a.Timeout = timeout
+	}
+}
+
+// WithDialer makes the client open connections through dialer.
+func WithDialer(dialer Dialer) Option {
+	return func(a *Airport) {
+		a.dialer = dialer
+	}
+}
+
+// WithLocalAddr binds outgoing connections to the given local address. It
+// has no effect together with WithDialer.
+func WithLocalAddr(ip net.IP) Option {
+	return func(a *Airport) {
+		a.localAddr = ip
+	}
+}
@@ -0,0 +1,160 @@
+package airport_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+func TestPortMappingBinary(t *testing.T) {
+	mapping := airport.PortMapping{Protocol: airport.ProtocolTCP, PublicPort: 8080, PrivateIP: net.IPv4(10, 0, 1, 20), PrivatePort: 80}
+
+	data, err := mapping.MarshalBinary()
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	var decoded airport.PortMapping
+	if err = decoded.UnmarshalBinary(data); nil != err {
+		t.Fatal(err)
+	}
+
+	if mapping.String() != decoded.String() {
+		t.Errorf("UnmarshalBinary() = %v, want %v", decoded, mapping)
+	}
+
+	invalid := []airport.PortMapping{
+		{Protocol: 1, PublicPort: 1, PrivateIP: mapping.PrivateIP, PrivatePort: 1},
+		{Protocol: airport.ProtocolUDP, PublicPort: 1, PrivateIP: net.ParseIP("fe80::1"), PrivatePort: 1},
+		{Protocol: airport.ProtocolUDP, PublicPort: 0, PrivateIP: mapping.PrivateIP, PrivatePort: 1},
+	}
+	for _, mapping := range invalid {
+		if _, err := mapping.MarshalBinary(); !errors.Is(err, airport.ErrInvalidValue) {
+			t.Errorf("MarshalBinary(%v) error = %v, want ErrInvalidValue", mapping, err)
+		}
+	}
+
+	if err := decoded.UnmarshalBinary(data[:15]); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("UnmarshalBinary(15 bytes) error = %v, want ErrInvalidValue", err)
+	}
+}
+
+func TestParseProtocol(t *testing.T) {
+	if protocol, err := airport.ParseProtocol("UDP"); nil != err || airport.ProtocolUDP != protocol {
+		t.Errorf("ParseProtocol(UDP) = %v, %v", protocol, err)
+	}
+
+	if _, err := airport.ParseProtocol("icmp"); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("ParseProtocol(icmp) error = %v, want ErrInvalidValue", err)
+	}
+}
+
+func TestPortMappings(t *testing.T) {
+	ctx := context.Background()
+	server := newServer(t, "secret")
+	server.Put(newRecord(t, "laIP", "10.0.1.1"))
+	server.Put(newRecord(t, "laSM", "255.255.255.0"))
+
+	a := server.Airport()
+	web := airport.PortMapping{Protocol: airport.ProtocolTCP, PublicPort: 8080, PrivateIP: net.IPv4(10, 0, 1, 20), PrivatePort: 80}
+	game := airport.PortMapping{Protocol: airport.ProtocolUDP, PublicPort: 8080, PrivateIP: net.IPv4(10, 0, 1, 21), PrivatePort: 9000}
+
+	for _, mapping := range []airport.PortMapping{web, game} {
+		if err := a.AddPortMapping(ctx, mapping); nil != err {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.AddPortMapping(ctx, web); !errors.Is(err, airport.ErrPortMappingConflict) {
+		t.Errorf("AddPortMapping() of a mapped port error = %v, want ErrPortMappingConflict", err)
+	}
+
+	outside := airport.PortMapping{Protocol: airport.ProtocolTCP, PublicPort: 22, PrivateIP: net.IPv4(192, 168, 0, 2), PrivatePort: 22}
+	if err := a.AddPortMapping(ctx, outside); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("AddPortMapping() outside the LAN error = %v, want ErrInvalidValue", err)
+	}
+
+	mappings, err := a.ListPortMappings(ctx)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 2 != len(mappings) || web.String() != mappings[0].String() || game.String() != mappings[1].String() {
+		t.Errorf("ListPortMappings() = %v, want %v and %v", mappings, web, game)
+	}
+
+	if err = a.RemovePortMapping(ctx, airport.ProtocolTCP, 8080); nil != err {
+		t.Fatal(err)
+	}
+	if err = a.RemovePortMapping(ctx, airport.ProtocolTCP, 8080); !errors.Is(err, airport.ErrPortMappingNotFound) {
+		t.Errorf("RemovePortMapping() of a missing mapping error = %v, want ErrPortMappingNotFound", err)
+	}
+
+	if mappings, err = a.ListPortMappings(ctx); nil != err || 1 != len(mappings) || game.String() != mappings[0].String() {
+		t.Errorf("ListPortMappings() = %v, %v, want %v", mappings, err, game)
+	}
+}
+
+func TestPortMappingUnknownProtocol(t *testing.T) {
+	ctx := context.Background()
+	server := newServer(t, "secret")
+	server.Put(newRecord(t, "laIP", "10.0.1.1"))
+	server.Put(newRecord(t, "laSM", "255.255.255.0"))
+
+	// a row with protocol 1 and trailing bytes this package does not know
+	unknown := []byte{0, 7, 10, 0, 1, 5, 0, 7, 1, 0, 0, 0, 0, 0, 0, 9}
+	row := airport.GetInfoRecord("pmTa")
+	row.SetValue(unknown)
+	server.Put(row)
+
+	a := server.Airport()
+	mapping := airport.PortMapping{Protocol: airport.ProtocolTCP, PublicPort: 8080, PrivateIP: net.IPv4(10, 0, 1, 20), PrivatePort: 80}
+	if err := a.AddPortMapping(ctx, mapping); nil != err {
+		t.Fatal(err)
+	}
+	if err := a.RemovePortMapping(ctx, airport.ProtocolTCP, 8080); nil != err {
+		t.Fatal(err)
+	}
+
+	rows := server.GetAll("pmTa")
+	if 1 != len(rows) || !bytes.Equal(unknown, rows[0].Value) {
+		t.Errorf("server holds %v, want the unknown row unchanged", rows)
+	}
+
+	mappings, err := a.ListPortMappings(ctx)
+	if nil != err || 1 != len(mappings) || "Protocol(1) 7 -> 10.0.1.5:7" != mappings[0].String() {
+		t.Errorf("ListPortMappings() = %v, %v", mappings, err)
+	}
+}
+
+func TestAddPortMappingWithoutLAN(t *testing.T) {
+	mapping := airport.PortMapping{Protocol: airport.ProtocolTCP, PublicPort: 8080, PrivateIP: net.IPv4(10, 0, 1, 20), PrivatePort: 80}
+
+	tests := []struct {
+		name    string
+		records []*airport.InfoRecord
+		err     error
+	}{
+		{"no address", []*airport.InfoRecord{newRecord(t, "laSM", "255.255.255.0")}, airport.ErrUnknownTag},
+		{"no mask", []*airport.InfoRecord{newRecord(t, "laIP", "10.0.1.1")}, airport.ErrUnknownTag},
+		{"invalid mask", []*airport.InfoRecord{newRecord(t, "laIP", "10.0.1.1"), newRecord(t, "laSM", "255.255.255.246")}, airport.ErrInvalidValue},
+	}
+
+	for _, test := range tests {
+		server := newServer(t, "secret")
+		for _, infoRecord := range test.records {
+			server.Put(infoRecord)
+		}
+
+		if err := server.Airport().AddPortMapping(context.Background(), mapping); !errors.Is(err, test.err) {
+			t.Errorf("%s: AddPortMapping() error = %v, want %v", test.name, err, test.err)
+		}
+
+		if nil != server.Get("pmTa") {
+			t.Errorf("%s: AddPortMapping() wrote the table", test.name)
+		}
+	}
+}
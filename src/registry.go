@@ -0,0 +1,191 @@
+package airport
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+//go:generate go run ../cmd/acpgen -spec tags.json -output tags.go
+
+// registryMutex guards tags against concurrent registration.
+var registryMutex sync.RWMutex
+
+// tagDefinition is the JSON form of a tag read by LoadTagDefinitions.
+type tagDefinition struct {
+	Tag         string           `json:"tag"`
+	Description string           `json:"description"`
+	DataType    RecordType       `json:"dataType"`
+	Encryption  RecordEncryption `json:"encryption"`
+	MaxLength   int32            `json:"maxLength"`
+	ReadOnly    bool             `json:"readOnly"`
+	Secret      bool             `json:"secret"`
+}
+
+// GetInfoRecord returns a fresh copy of the registered record for tag.
+// Unregistered tags get an unencrypted TypeByteString record without a
+// length limit.
+func GetInfoRecord(tag string) *InfoRecord {
+	if infoRecord := lookupInfoRecord(tag); nil != infoRecord {
+		return infoRecord
+	}
+
+	return NewInfoRecord(tag, "", TypeByteString, EncryptionUnencrypted, 0, make([]byte, 0))
+}
+
+// GetAllInfoRecords returns fresh copies of all registered records.
+func GetAllInfoRecords() map[string]*InfoRecord {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	recs := make(map[string]*InfoRecord)
+	for tag, tagStruct := range tags {
+		record := tagStruct
+		recs[tag] = &record
+	}
+	return recs
+}
+
+// RegisteredTags returns all registered tags, sorted.
+func RegisteredTags() []string {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	registered := make([]string, 0, len(tags))
+	for tag := range tags {
+		registered = append(registered, tag)
+	}
+	sort.Strings(registered)
+
+	return registered
+}
+
+// RegisterTag adds a tag to the registry, so that it is read and written
+// with the given metadata. Tags can only be registered once.
+func RegisterTag(record InfoRecord) error {
+	if err := validateTagDefinition(record); nil != err {
+		return err
+	}
+
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	if _, ok := tags[record.Tag]; ok {
+		return fmt.Errorf("%w: %s", ErrTagRegistered, record.Tag)
+	}
+
+	record.Value = nil
+	tags[record.Tag] = record
+	return nil
+}
+
+// LoadTagDefinitions registers tags from a JSON array of definitions like
+//
+//	[{"tag": "syVs", "description": "Firmware version", "dataType": "string",
+//	  "encryption": "unencrypted", "maxLength": 32, "readOnly": true}]
+//
+// Data types and encryptions take the names RecordType.UnmarshalText and
+// RecordEncryption.UnmarshalText accept. Either all definitions are
+// registered or, on error, none.
+func LoadTagDefinitions(r io.Reader) error {
+	var definitions []tagDefinition
+	if err := json.NewDecoder(r).Decode(&definitions); nil != err {
+		return fmt.Errorf("airport: tag definitions: %w", err)
+	}
+
+	records := make([]InfoRecord, 0, len(definitions))
+	seen := make(map[string]bool)
+	for _, definition := range definitions {
+		record := InfoRecord{
+			Tag:         definition.Tag,
+			Description: definition.Description,
+			DataType:    definition.DataType,
+			Encryption:  definition.Encryption,
+			MaxLength:   definition.MaxLength,
+			ReadOnly:    definition.ReadOnly,
+			Secret:      definition.Secret,
+		}
+		if err := validateTagDefinition(record); nil != err {
+			return err
+		}
+
+		if seen[record.Tag] {
+			return fmt.Errorf("%w: %s is defined twice", ErrTagRegistered, record.Tag)
+		}
+		seen[record.Tag] = true
+
+		records = append(records, record)
+	}
+
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	for _, record := range records {
+		if _, ok := tags[record.Tag]; ok {
+			return fmt.Errorf("%w: %s", ErrTagRegistered, record.Tag)
+		}
+	}
+
+	for _, record := range records {
+		tags[record.Tag] = record
+	}
+
+	return nil
+}
+
+func validateTagDefinition(record InfoRecord) error {
+	if 4 != len(record.Tag) {
+		return fmt.Errorf("%w: tag %q is not 4 characters long", ErrMalformedRecord, record.Tag)
+	}
+
+	for _, b := range []byte(record.Tag) {
+		if b < 0x20 || b > 0x7e {
+			return fmt.Errorf("%w: tag %q is not printable", ErrMalformedRecord, record.Tag)
+		}
+	}
+
+	if _, err := record.DataType.MarshalText(); nil != err {
+		return err
+	}
+
+	if _, err := record.Encryption.MarshalText(); nil != err {
+		return err
+	}
+
+	if 0 > record.MaxLength {
+		return fmt.Errorf("%w: %s: negative maximum length %d", ErrMalformedRecord, record.Tag, record.MaxLength)
+	}
+
+	return nil
+}
+
+// lookupInfoRecord returns a fresh copy of the registered record for tag, or
+// nil when the tag is not registered.
+func lookupInfoRecord(tag string) *InfoRecord {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	tagStruct, ok := tags[tag]
+	if !ok {
+		return nil
+	}
+
+	record := tagStruct
+	return &record
+}
+
+// isReadOnly reports whether tag is registered as read-only.
+func isReadOnly(tag string) bool {
+	infoRecord := lookupInfoRecord(tag)
+
+	return nil != infoRecord && infoRecord.ReadOnly
+}
+
+// isSecret reports whether tag is registered as secret.
+func isSecret(tag string) bool {
+	infoRecord := lookupInfoRecord(tag)
+
+	return nil != infoRecord && infoRecord.Secret
+}
@@ -0,0 +1,133 @@
+package airport_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+// The registry is global and tags cannot be unregistered, so each test uses
+// its own tags.
+
+func TestRegisterTag(t *testing.T) {
+	record := airport.InfoRecord{Tag: "xRg1", Description: "Test", DataType: airport.TypeUnsignedInteger, Encryption: airport.EncryptionUnencrypted, MaxLength: 4}
+	if err := airport.RegisterTag(record); nil != err {
+		t.Fatal(err)
+	}
+
+	if err := airport.RegisterTag(record); !errors.Is(err, airport.ErrTagRegistered) {
+		t.Errorf("RegisterTag() twice error = %v, want ErrTagRegistered", err)
+	}
+	if err := airport.RegisterTag(airport.InfoRecord{Tag: "syNm", DataType: airport.TypeCharString}); !errors.Is(err, airport.ErrTagRegistered) {
+		t.Errorf("RegisterTag() of a built-in tag error = %v, want ErrTagRegistered", err)
+	}
+
+	if got := airport.GetInfoRecord("xRg1"); airport.TypeUnsignedInteger != got.DataType || "Test" != got.Description {
+		t.Errorf("GetInfoRecord() = %+v", got)
+	}
+}
+
+func TestRegisterTagValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		record airport.InfoRecord
+	}{
+		{"short tag", airport.InfoRecord{Tag: "xRg", DataType: airport.TypeByte}},
+		{"long tag", airport.InfoRecord{Tag: "xRg22", DataType: airport.TypeByte}},
+		{"unprintable tag", airport.InfoRecord{Tag: "xR\x00g", DataType: airport.TypeByte}},
+		{"unknown type", airport.InfoRecord{Tag: "xRg2", DataType: 99}},
+		{"unknown encryption", airport.InfoRecord{Tag: "xRg2", DataType: airport.TypeByte, Encryption: 1}},
+		{"negative length", airport.InfoRecord{Tag: "xRg2", DataType: airport.TypeByte, MaxLength: -1}},
+	}
+
+	for _, test := range tests {
+		if err := airport.RegisterTag(test.record); nil == err {
+			t.Errorf("%s: RegisterTag() succeeded", test.name)
+		}
+	}
+
+	if tags := strings.Join(airport.RegisteredTags(), ","); strings.Contains(tags, "xRg2") {
+		t.Errorf("RegisteredTags() contains a rejected tag")
+	}
+}
+
+func TestLoadTagDefinitions(t *testing.T) {
+	definitions := `[
+		{"tag": "xLd1", "description": "Firmware version", "dataType": "string", "encryption": "encrypted", "maxLength": 32},
+		{"tag": "xLd2", "description": "Uptime", "dataType": "TypeUnsignedInteger", "encryption": "none", "maxLength": 4, "readOnly": true},
+		{"tag": "xLd3", "description": "Key", "dataType": "hex", "encryption": "plain", "maxLength": 8, "secret": true}
+	]`
+	if err := airport.LoadTagDefinitions(strings.NewReader(definitions)); nil != err {
+		t.Fatal(err)
+	}
+
+	got := airport.GetInfoRecord("xLd1")
+	if airport.TypeCharString != got.DataType || airport.EncryptionEncrypted != got.Encryption || 32 != got.MaxLength {
+		t.Errorf("xLd1 = %+v", got)
+	}
+	if got := airport.GetInfoRecord("xLd2"); airport.TypeUnsignedInteger != got.DataType || !got.ReadOnly {
+		t.Errorf("xLd2 = %+v", got)
+	}
+	if got := airport.GetInfoRecord("xLd3"); airport.TypeByteString != got.DataType || !got.Secret {
+		t.Errorf("xLd3 = %+v", got)
+	}
+
+	// registered tags are parsed with their metadata
+	value := airport.EncryptBytes(airport.CipherBytes, []byte("7.6.8"))
+	info, err := airport.ParseInfo(append(rawRecord("xLd1", 2, value), rawRecord("xLd2", 0, []byte{0, 0, 1, 0})...))
+	if nil != err {
+		t.Fatal(err)
+	}
+	if got := info.Get("xLd1"); nil == got || "7.6.8" != got.String() {
+		t.Errorf("ParseInfo() xLd1 = %v, want 7.6.8", got)
+	}
+	if got := info.Get("xLd2"); nil == got || "256" != got.String() {
+		t.Errorf("ParseInfo() xLd2 = %v, want 256", got)
+	}
+
+	// and written with them
+	server := newServer(t, "secret")
+	if err := server.Airport().SetProperty(context.Background(), "xLd1", "7.7.3"); nil != err {
+		t.Fatal(err)
+	}
+	if got := server.Get("xLd1"); nil == got || !bytes.Equal([]byte("7.7.3"), got.Value) {
+		t.Errorf("written xLd1 = %v, want 7.7.3", got)
+	}
+	if err := server.Airport().SetProperty(context.Background(), "xLd2", "1"); !errors.Is(err, airport.ErrReadOnlyTag) {
+		t.Errorf("SetProperty() of a read-only tag error = %v, want ErrReadOnlyTag", err)
+	}
+	if err := server.Airport().SetProperty(context.Background(), "xLd1", strings.Repeat("x", 32)); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("SetProperty() over MaxLength error = %v, want ErrInvalidValue", err)
+	}
+}
+
+func TestLoadTagDefinitionsAllOrNothing(t *testing.T) {
+	tests := []struct {
+		name        string
+		definitions string
+		err         error
+	}{
+		{"bad length", `[{"tag": "xAn1", "dataType": "byte"}, {"tag": "xAn", "dataType": "byte"}]`, airport.ErrMalformedRecord},
+		{"registered", `[{"tag": "xAn1", "dataType": "byte"}, {"tag": "syNm", "dataType": "string"}]`, airport.ErrTagRegistered},
+		{"defined twice", `[{"tag": "xAn1", "dataType": "byte"}, {"tag": "xAn1", "dataType": "byte"}]`, airport.ErrTagRegistered},
+		{"bad type name", `[{"tag": "xAn1", "dataType": "byte"}, {"tag": "xAn2", "dataType": "float"}]`, nil},
+		{"bad encryption name", `[{"tag": "xAn1", "dataType": "byte"}, {"tag": "xAn2", "dataType": "byte", "encryption": "aes"}]`, nil},
+		{"not an array", `{"tag": "xAn1"}`, nil},
+	}
+
+	for _, test := range tests {
+		err := airport.LoadTagDefinitions(strings.NewReader(test.definitions))
+		if nil == err || (nil != test.err && !errors.Is(err, test.err)) {
+			t.Errorf("%s: LoadTagDefinitions() error = %v, want %v", test.name, err, test.err)
+		}
+
+		// the valid definition before the bad one is not registered either
+		if got := airport.GetInfoRecord("xAn1"); airport.TypeByte == got.DataType {
+			t.Errorf("%s: LoadTagDefinitions() registered part of the batch", test.name)
+		}
+	}
+}
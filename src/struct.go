@@ -0,0 +1,210 @@
+package airport
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/netip"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	ipType       = reflect.TypeOf(net.IP{})
+	addrType     = reflect.TypeOf(netip.Addr{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	bytesType    = reflect.TypeOf([]byte{})
+)
+
+// structField is an exported struct field tagged with `acp:"tag"`.
+type structField struct {
+	index     int
+	name      string
+	tag       string
+	omitEmpty bool
+}
+
+// Marshal converts a struct, or a pointer to one, into an Info. Exported
+// fields tagged like `acp:"syNm"` become records of the registered tag;
+// `acp:"syNm,omitempty"` skips zero values. Fields may be string, uint32,
+// bool, net.IP, netip.Addr, time.Duration (stored in seconds) or []byte.
+func Marshal(v any) (*Info, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if reflect.Struct != value.Kind() {
+		return nil, fmt.Errorf("airport: cannot marshal %T, need a struct", v)
+	}
+
+	fields, err := structFields(value.Type())
+	if nil != err {
+		return nil, err
+	}
+
+	info := newInfo()
+	for _, field := range fields {
+		fieldValue := value.Field(field.index)
+		if field.omitEmpty && fieldValue.IsZero() {
+			continue
+		}
+
+		infoRecord := lookupInfoRecord(field.tag)
+		if nil == infoRecord {
+			return nil, fmt.Errorf("%w: %s (field %s)", ErrUnknownTag, field.tag, field.name)
+		}
+
+		err = setField(infoRecord, fieldValue)
+		if nil != err {
+			return nil, fmt.Errorf("airport: field %s: %w", field.name, err)
+		}
+
+		info.Put(field.tag, infoRecord)
+	}
+
+	return info, nil
+}
+
+// Unmarshal stores records from info in the tagged fields of the struct v
+// points to, see Marshal. Fields whose record the station did not return are
+// left untouched.
+func Unmarshal(info *Info, v any) error {
+	pointer := reflect.ValueOf(v)
+	if reflect.Pointer != pointer.Kind() || pointer.IsNil() || reflect.Struct != pointer.Elem().Kind() {
+		return fmt.Errorf("airport: cannot unmarshal into %T, need a pointer to a struct", v)
+	}
+	value := pointer.Elem()
+
+	fields, err := structFields(value.Type())
+	if nil != err {
+		return err
+	}
+
+	for _, field := range fields {
+		infoRecord := info.Get(field.tag)
+		if nil == infoRecord || RecordPresent != info.State(field.tag) {
+			continue
+		}
+
+		err = getField(infoRecord, value.Field(field.index))
+		if nil != err {
+			return fmt.Errorf("airport: field %s: %w", field.name, err)
+		}
+	}
+
+	return nil
+}
+
+func structFields(structType reflect.Type) ([]structField, error) {
+	var fields []structField
+
+	for index := 0; index < structType.NumField(); index++ {
+		field := structType.Field(index)
+		tag, ok := field.Tag.Lookup("acp")
+		if !ok || "-" == tag || !field.IsExported() {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if 4 != len(name) {
+			return nil, fmt.Errorf("airport: field %s: tag %q is not 4 characters long", field.Name, name)
+		}
+
+		fields = append(fields, structField{
+			index:     index,
+			name:      field.Name,
+			tag:       name,
+			omitEmpty: "omitempty" == options,
+		})
+	}
+
+	return fields, nil
+}
+
+func setField(infoRecord *InfoRecord, value reflect.Value) error {
+	switch value.Type() {
+	case ipType:
+		return infoRecord.SetIP(value.Interface().(net.IP))
+	case addrType:
+		addr := value.Interface().(netip.Addr)
+		if !addr.Is4() {
+			return fmt.Errorf("%w: %s: %v is not an IPv4 address", ErrInvalidValue, infoRecord.Tag, addr)
+		}
+		return infoRecord.SetIP(net.IP(addr.AsSlice()))
+	case durationType:
+		seconds := value.Interface().(time.Duration) / time.Second
+		if 0 > seconds || math.MaxUint32 < seconds {
+			return fmt.Errorf("%w: %s: %v is out of range", ErrInvalidValue, infoRecord.Tag, value.Interface())
+		}
+		return infoRecord.SetUint32(uint32(seconds))
+	case bytesType:
+		return infoRecord.SetBytes(value.Bytes())
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		if TypeCharString == infoRecord.DataType || TypePhoneNumber == infoRecord.DataType {
+			return infoRecord.SetText(value.String())
+		}
+		return infoRecord.SetBytesFromString(value.String())
+	case reflect.Uint32:
+		return infoRecord.SetUint32(uint32(value.Uint()))
+	case reflect.Bool:
+		return infoRecord.SetBool(value.Bool())
+	}
+
+	return fmt.Errorf("%w: unsupported field type %s", ErrTypeMismatch, value.Type())
+}
+
+func getField(infoRecord *InfoRecord, value reflect.Value) error {
+	switch value.Type() {
+	case ipType:
+		ip, err := infoRecord.IP()
+		if nil != err {
+			return err
+		}
+		value.Set(reflect.ValueOf(ip))
+		return nil
+	case addrType:
+		ip, err := infoRecord.IP()
+		if nil != err {
+			return err
+		}
+		value.Set(reflect.ValueOf(netip.AddrFrom4([4]byte(ip.To4()))))
+		return nil
+	case durationType:
+		seconds, err := infoRecord.Uint32()
+		if nil != err {
+			return err
+		}
+		value.Set(reflect.ValueOf(time.Duration(seconds) * time.Second))
+		return nil
+	case bytesType:
+		bytes, err := infoRecord.Bytes()
+		if nil != err {
+			return err
+		}
+		value.SetBytes(bytes)
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(infoRecord.String())
+		return nil
+	case reflect.Uint32:
+		number, err := infoRecord.Uint32()
+		if nil != err {
+			return err
+		}
+		value.SetUint(uint64(number))
+		return nil
+	case reflect.Bool:
+		flag, err := infoRecord.Bool()
+		if nil != err {
+			return err
+		}
+		value.SetBool(flag)
+		return nil
+	}
+
+	return fmt.Errorf("%w: unsupported field type %s", ErrTypeMismatch, value.Type())
+}
@@ -0,0 +1,177 @@
+package airport_test
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+type stationConfig struct {
+	Name        string        `acp:"syNm"`
+	Channel     uint32        `acp:"raCh"`
+	Closed      bool          `acp:"raCl"`
+	Address     net.IP        `acp:"laIP"`
+	Mask        netip.Addr    `acp:"laSM"`
+	IdleTimeout time.Duration `acp:"peID"`
+	Key         []byte        `acp:"raWE"`
+	Ignored     string        `acp:"-"`
+	untagged    string
+}
+
+func TestMarshal(t *testing.T) {
+	config := stationConfig{
+		Name:        "Office",
+		Channel:     6,
+		Closed:      true,
+		Address:     net.IPv4(10, 0, 1, 1),
+		Mask:        netip.MustParseAddr("255.255.255.0"),
+		IdleTimeout: 10 * time.Minute,
+		Key:         []byte{1, 2, 3, 4, 5},
+		Ignored:     "x",
+		untagged:    "x",
+	}
+
+	info, err := airport.Marshal(&config)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	want := map[string][]byte{
+		"syNm": []byte("Office"),
+		"raCh": {0, 0, 0, 6},
+		"raCl": {1},
+		"laIP": {10, 0, 1, 1},
+		"laSM": {255, 255, 255, 0},
+		"peID": {0, 0, 0x02, 0x58},
+		"raWE": {1, 2, 3, 4, 5},
+	}
+	if got := info.Tags(); len(want) != len(got) {
+		t.Errorf("Marshal() tags = %v, want %d", got, len(want))
+	}
+	for tag, value := range want {
+		if got := info.Get(tag); nil == got || !bytes.Equal(value, got.Value) {
+			t.Errorf("Marshal() %s = %v, want %x", tag, got, value)
+		}
+	}
+
+	var got stationConfig
+	if err := airport.Unmarshal(info, &got); nil != err {
+		t.Fatal(err)
+	}
+	config.Ignored, config.untagged = "", ""
+	if config.Name != got.Name || config.Channel != got.Channel || config.Closed != got.Closed ||
+		!config.Address.Equal(got.Address) || config.Mask != got.Mask ||
+		config.IdleTimeout != got.IdleTimeout || !bytes.Equal(config.Key, got.Key) ||
+		"" != got.Ignored || "" != got.untagged {
+		t.Errorf("Unmarshal() = %+v, want %+v", got, config)
+	}
+}
+
+func TestMarshalOmitEmpty(t *testing.T) {
+	config := struct {
+		Name    string `acp:"syNm,omitempty"`
+		Channel uint32 `acp:"raCh,omitempty"`
+		Closed  bool   `acp:"raCl"`
+	}{Channel: 6}
+
+	info, err := airport.Marshal(config)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if nil != info.Get("syNm") {
+		t.Errorf("Marshal() kept the empty name")
+	}
+	if got := info.Get("raCh"); nil == got || !bytes.Equal([]byte{0, 0, 0, 6}, got.Value) {
+		t.Errorf("Marshal() raCh = %v", got)
+	}
+	// without omitempty zero values are written
+	if got := info.Get("raCl"); nil == got || !bytes.Equal([]byte{0}, got.Value) {
+		t.Errorf("Marshal() raCl = %v", got)
+	}
+}
+
+func TestMarshalErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		err   error
+	}{
+		{"unregistered tag", struct {
+			Value string `acp:"zzzz"`
+		}{"x"}, airport.ErrUnknownTag},
+		{"IPv6 netip.Addr", struct {
+			Value netip.Addr `acp:"laIP"`
+		}{netip.MustParseAddr("fe80::1")}, airport.ErrInvalidValue},
+		{"negative duration", struct {
+			Value time.Duration `acp:"peID"`
+		}{-time.Second}, airport.ErrInvalidValue},
+		{"duration too long", struct {
+			Value time.Duration `acp:"peID"`
+		}{(math.MaxUint32 + 1) * time.Second}, airport.ErrInvalidValue},
+		{"unsupported type", struct {
+			Value int `acp:"raCh"`
+		}{6}, airport.ErrTypeMismatch},
+		{"wrong record type", struct {
+			Value bool `acp:"syNm"`
+		}{true}, airport.ErrTypeMismatch},
+	}
+
+	for _, test := range tests {
+		if _, err := airport.Marshal(test.value); !errors.Is(err, test.err) {
+			t.Errorf("%s: Marshal() error = %v, want %v", test.name, err, test.err)
+		}
+	}
+
+	badTag := struct {
+		Value string `acp:"name"`
+		Other string `acp:"toolong"`
+	}{}
+	if _, err := airport.Marshal(badTag); nil == err {
+		t.Errorf("Marshal() of a 7 character tag succeeded")
+	}
+	if _, err := airport.Marshal("syNm"); nil == err {
+		t.Errorf("Marshal() of a string succeeded")
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	data := append(rawRecord("moID", 0, []byte{0xFF, 0xFF, 0xFF, 0xF6}), rawRecord("raCh", 0, []byte{0, 0, 0, 6})...)
+	info, err := airport.ParseInfo(data)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	var config struct {
+		Channel uint32 `acp:"raCh"`
+		Timeout uint32 `acp:"moID"`
+		Name    string `acp:"syNm"`
+	}
+	config.Timeout = 30
+	config.Name = "Office"
+	if err := airport.Unmarshal(info, &config); nil != err {
+		t.Fatal(err)
+	}
+
+	// invalid and missing records leave the fields untouched
+	if 6 != config.Channel || 30 != config.Timeout || "Office" != config.Name {
+		t.Errorf("Unmarshal() = %+v", config)
+	}
+
+	var unsupported struct {
+		Channel int `acp:"raCh"`
+	}
+	if err := airport.Unmarshal(info, &unsupported); !errors.Is(err, airport.ErrTypeMismatch) {
+		t.Errorf("Unmarshal() into an int error = %v, want ErrTypeMismatch", err)
+	}
+
+	if err := airport.Unmarshal(info, config); nil == err {
+		t.Errorf("Unmarshal() into a struct value succeeded")
+	}
+}
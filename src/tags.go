@@ -1,390 +1,1440 @@
+// Code generated by "acpgen -spec tags.json -output tags.go"; DO NOT EDIT.
+
 package airport
 
+import (
+	"context"
+	"net"
+)
+
+// Tags known to the registry.
+const (
+	// TagReadCommunity is the "syPR" tag: Read community.
+	TagReadCommunity = "syPR"
+	// TagReadWriteCommunity is the "syPW" tag: Read/write community.
+	TagReadWriteCommunity = "syPW"
+	// TagConfigurationMode is the "waCV" tag: Configuration mode.
+	TagConfigurationMode = "waCV"
+	// TagWANInterface is the "waIn" tag: Ethernet/Modem switch 1.
+	TagWANInterface = "waIn"
+	// TagMicrowaveRobustness is the "raRo" tag: Microwave robustness flag.
+	TagMicrowaveRobustness = "raRo"
+	// TagClosedNetwork is the "raCl" tag: Closed network flag.
+	TagClosedNetwork = "raCl"
+	// TagAccessPointDensity is the "raDe" tag: Access point density.
+	TagAccessPointDensity = "raDe"
+	// TagMulticastRate is the "raMu" tag: Multicast rate.
+	TagMulticastRate = "raMu"
+	// TagWirelessChannel is the "raCh" tag: Wireless channel.
+	TagWirelessChannel = "raCh"
+	// TagModemTimeout is the "moID" tag: Modem timeout.
+	TagModemTimeout = "moID"
+	// TagDialingType is the "moPD" tag: Dialing type (tone or pulse).
+	TagDialingType = "moPD"
+	// TagAutomaticDial is the "moAD" tag: Automatic dial.
+	TagAutomaticDial = "moAD"
+	// TagPhoneCountryCode is the "moCC" tag: Phone country code.
+	TagPhoneCountryCode = "moCC"
+	// TagModemCountryIndex is the "moCI" tag: Modem country code combo box index.
+	TagModemCountryIndex = "moCI"
+	// TagNetworkName is the "raNm" tag: Network name.
+	TagNetworkName = "raNm"
+	// TagPrimaryPhoneNumber is the "moPN" tag: Primary phone number.
+	TagPrimaryPhoneNumber = "moPN"
+	// TagSecondaryPhoneNumber is the "moAP" tag: Secondary phone number.
+	TagSecondaryPhoneNumber = "moAP"
+	// TagPPPoEIdleTimeout is the "peID" tag: PPPoE idle timeout.
+	TagPPPoEIdleTimeout = "peID"
+	// TagPPPoEAutoConnect is the "peAC" tag: PPPoE auto connect.
+	TagPPPoEAutoConnect = "peAC"
+	// TagPPPoEStayConnected is the "peSC" tag: PPPoE stay connected.
+	TagPPPoEStayConnected = "peSC"
+	// TagEncryptionSwitch is the "raWM" tag: Encryption switch.
+	TagEncryptionSwitch = "raWM"
+	// TagEncryptionKey is the "raWE" tag: Encryption key.
+	TagEncryptionKey = "raWE"
+	// TagLANAddress is the "laIP" tag: Private LAN base station address.
+	TagLANAddress = "laIP"
+	// TagLANSubnetMask is the "laSM" tag: Private LAN subnet mask.
+	TagLANSubnetMask = "laSM"
+	// TagWirelessBridging is the "raWB" tag: Wireless to Ethernet bridging switch.
+	TagWirelessBridging = "raWB"
+	// TagAccessControlEnabled is the "acEn" tag: Access control switch.
+	TagAccessControlEnabled = "acEn"
+	// TagAccessControlTable is the "acTa" tag: Access control info.
+	TagAccessControlTable = "acTa"
+	// TagWirelessDHCP is the "raDS" tag: Wireless DHCP switch.
+	TagWirelessDHCP = "raDS"
+	// TagLANDHCP is the "laDS" tag: LAN Ethernet DHCP switch.
+	TagLANDHCP = "laDS"
+	// TagWANDHCP is the "waDS" tag: WAN Ethernet DHCP switch.
+	TagWANDHCP = "waDS"
+	// TagNAT is the "raNA" tag: NAT switch.
+	TagNAT = "raNA"
+	// TagWANAddress is the "waIP" tag: Base station IP address.
+	TagWANAddress = "waIP"
+	// TagRouterAddress is the "waRA" tag: Router IP address.
+	TagRouterAddress = "waRA"
+	// TagWANSubnetMask is the "waSM" tag: Subnet mask.
+	TagWANSubnetMask = "waSM"
+	// TagContact is the "syCt" tag: Contact person name.
+	TagContact = "syCt"
+	// TagStationName is the "syNm" tag: Base station name.
+	TagStationName = "syNm"
+	// TagLocation is the "syLo" tag: Base station location.
+	TagLocation = "syLo"
+	// TagDHCPClientID is the "waDC" tag: DHCP client ID.
+	TagDHCPClientID = "waDC"
+	// TagDHCPRangeStart is the "dhBg" tag: DHCP address range start.
+	TagDHCPRangeStart = "dhBg"
+	// TagDHCPRangeEnd is the "dhEn" tag: DHCP address range end.
+	TagDHCPRangeEnd = "dhEn"
+	// TagPrimaryDNS is the "waD1" tag: Primary DNS server.
+	TagPrimaryDNS = "waD1"
+	// TagSecondaryDNS is the "waD2" tag: Secondary DNS server.
+	TagSecondaryDNS = "waD2"
+	// TagDHCPLeaseTime is the "dhLe" tag: DHCP lease time.
+	TagDHCPLeaseTime = "dhLe"
+	// TagDomainName is the "waDN" tag: Domain name.
+	TagDomainName = "waDN"
+	// TagPortMappingTable is the "pmTa" tag: Port mapping.
+	TagPortMappingTable = "pmTa"
+	// TagDialUpUsername is the "moUN" tag: Dial-up username.
+	TagDialUpUsername = "moUN"
+	// TagDialUpPassword is the "moPW" tag: Dial-up password.
+	TagDialUpPassword = "moPW"
+	// TagPPPoEUsername is the "peUN" tag: PPPoE username.
+	TagPPPoEUsername = "peUN"
+	// TagPPPoEPassword is the "pePW" tag: PPPoE password.
+	TagPPPoEPassword = "pePW"
+	// TagPPPoEServiceName is the "peSN" tag: PPPoE service name.
+	TagPPPoEServiceName = "peSN"
+	// TagReboot is the "acRB" tag: Reboot flag.
+	TagReboot = "acRB"
+	// TagBuildHash is the "buil" tag: Software build hash.
+	TagBuildHash = "buil"
+)
+
 var tags = map[string]InfoRecord{
-	"syPR": InfoRecord{
+	TagReadCommunity: InfoRecord{
 		MaxLength:   32,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "Read community",
-		Tag:         "syPR",
+		Tag:         TagReadCommunity,
+		Secret:      true,
 	},
-	"syPW": InfoRecord{
+	TagReadWriteCommunity: InfoRecord{
 		MaxLength:   32,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "Read/write community",
-		Tag:         "syPW",
+		Tag:         TagReadWriteCommunity,
+		Secret:      true,
 	},
-	"waCV": InfoRecord{
+	TagConfigurationMode: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeByteString,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Configuration mode",
-		Tag:         "waCV",
+		Tag:         TagConfigurationMode,
 	},
-	"waIn": InfoRecord{
+	TagWANInterface: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeByteString,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Ethernet/Modem switch 1",
-		Tag:         "waIn",
+		Tag:         TagWANInterface,
 	},
-	"raRo": InfoRecord{
+	TagMicrowaveRobustness: InfoRecord{
 		MaxLength:   1,
 		DataType:    TypeByte,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Microwave robustness flag",
-		Tag:         "raRo",
+		Tag:         TagMicrowaveRobustness,
 	},
-	"raCl": InfoRecord{
+	TagClosedNetwork: InfoRecord{
 		MaxLength:   1,
 		DataType:    TypeByte,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Closed network flag",
-		Tag:         "raCl",
+		Tag:         TagClosedNetwork,
 	},
-	"raDe": InfoRecord{
+	TagAccessPointDensity: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeByteString,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Access point density",
-		Tag:         "raDe",
+		Tag:         TagAccessPointDensity,
 	},
-	"raMu": InfoRecord{
+	TagMulticastRate: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeByteString,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Multicast rate",
-		Tag:         "raMu",
+		Tag:         TagMulticastRate,
 	},
-	"raCh": InfoRecord{
+	TagWirelessChannel: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeUnsignedInteger,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Wireless channel",
-		Tag:         "raCh",
+		Tag:         TagWirelessChannel,
 	},
-	"moID": InfoRecord{
+	TagModemTimeout: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeUnsignedInteger,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Modem timeout",
-		Tag:         "moID",
+		Tag:         TagModemTimeout,
 	},
-	"moPD": InfoRecord{
+	TagDialingType: InfoRecord{
 		MaxLength:   1,
 		DataType:    TypeByte,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Dialing type (tone or pulse)",
-		Tag:         "moPD",
+		Tag:         TagDialingType,
 	},
-	"moAD": InfoRecord{
+	TagAutomaticDial: InfoRecord{
 		MaxLength:   1,
 		DataType:    TypeByte,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Automatic dial",
-		Tag:         "moAD",
+		Tag:         TagAutomaticDial,
 	},
-	"moCC": InfoRecord{
+	TagPhoneCountryCode: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeUnsignedInteger,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Phone country code",
-		Tag:         "moCC",
+		Tag:         TagPhoneCountryCode,
 	},
-	"moCI": InfoRecord{
+	TagModemCountryIndex: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeUnsignedInteger,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Modem country code combo box index",
-		Tag:         "moCI",
+		Tag:         TagModemCountryIndex,
 	},
-	"raNm": InfoRecord{
+	TagNetworkName: InfoRecord{
 		MaxLength:   32,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "Network name",
-		Tag:         "raNm",
+		Tag:         TagNetworkName,
 	},
-	"moPN": InfoRecord{
+	TagPrimaryPhoneNumber: InfoRecord{
 		MaxLength:   32,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "Primary phone number",
-		Tag:         "moPN",
+		Tag:         TagPrimaryPhoneNumber,
 	},
-	"moAP": InfoRecord{
+	TagSecondaryPhoneNumber: InfoRecord{
 		MaxLength:   32,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "Secondary phone number",
-		Tag:         "moAP",
+		Tag:         TagSecondaryPhoneNumber,
 	},
-	"peID": InfoRecord{
+	TagPPPoEIdleTimeout: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeUnsignedInteger,
 		Encryption:  EncryptionUnencrypted,
 		Description: "PPPoE idle timeout",
-		Tag:         "peID",
+		Tag:         TagPPPoEIdleTimeout,
 	},
-	"peAC": InfoRecord{
+	TagPPPoEAutoConnect: InfoRecord{
 		MaxLength:   1,
 		DataType:    TypeByte,
 		Encryption:  EncryptionUnencrypted,
 		Description: "PPPoE auto connect",
-		Tag:         "peAC",
+		Tag:         TagPPPoEAutoConnect,
 	},
-	"peSC": InfoRecord{
+	TagPPPoEStayConnected: InfoRecord{
 		MaxLength:   1,
 		DataType:    TypeByte,
 		Encryption:  EncryptionUnencrypted,
 		Description: "PPPoE stay connected",
-		Tag:         "peSC",
+		Tag:         TagPPPoEStayConnected,
 	},
-	"raWM": InfoRecord{
+	TagEncryptionSwitch: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeByteString,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Encryption switch",
-		Tag:         "raWM",
+		Tag:         TagEncryptionSwitch,
 	},
-	"raWE": InfoRecord{
+	TagEncryptionKey: InfoRecord{
 		MaxLength:   13,
 		DataType:    TypeByteString,
 		Encryption:  EncryptionEncrypted,
 		Description: "Encryption key",
-		Tag:         "raWE",
+		Tag:         TagEncryptionKey,
+		Secret:      true,
 	},
-	"laIP": InfoRecord{
+	TagLANAddress: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeIPAddress,
 		Encryption:  EncryptionEncrypted,
 		Description: "Private LAN base station address",
-		Tag:         "laIP",
+		Tag:         TagLANAddress,
 	},
-	"laSM": InfoRecord{
+	TagLANSubnetMask: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeIPAddress,
 		Encryption:  EncryptionEncrypted,
 		Description: "Private LAN subnet mask",
-		Tag:         "laSM",
+		Tag:         TagLANSubnetMask,
 	},
-	"raWB": InfoRecord{
+	TagWirelessBridging: InfoRecord{
 		MaxLength:   1,
 		DataType:    TypeByte,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Wireless to Ethernet bridging switch",
-		Tag:         "raWB",
+		Tag:         TagWirelessBridging,
 	},
-	"acEn": InfoRecord{
+	TagAccessControlEnabled: InfoRecord{
 		MaxLength:   1,
 		DataType:    TypeByte,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Access control switch",
-		Tag:         "acEn",
+		Tag:         TagAccessControlEnabled,
 	},
-	"acTa": InfoRecord{
+	TagAccessControlTable: InfoRecord{
 		MaxLength:   16,
 		DataType:    TypeByteString,
 		Encryption:  EncryptionEncrypted,
 		Description: "Access control info",
-		Tag:         "acTa",
+		Tag:         TagAccessControlTable,
 	},
-	"raDS": InfoRecord{
+	TagWirelessDHCP: InfoRecord{
 		MaxLength:   10,
 		DataType:    TypeByte,
 		Encryption:  EncryptionEncrypted,
 		Description: "Wireless DHCP switch",
-		Tag:         "raDS",
+		Tag:         TagWirelessDHCP,
 	},
-	"laDS": InfoRecord{
+	TagLANDHCP: InfoRecord{
 		MaxLength:   1,
 		DataType:    TypeByte,
 		Encryption:  EncryptionUnencrypted,
 		Description: "LAN Ethernet DHCP switch",
-		Tag:         "laDS",
+		Tag:         TagLANDHCP,
 	},
-	"waDS": InfoRecord{
+	TagWANDHCP: InfoRecord{
 		MaxLength:   1,
 		DataType:    TypeByte,
 		Encryption:  EncryptionEncrypted,
 		Description: "WAN Ethernet DHCP switch",
-		Tag:         "waDS",
+		Tag:         TagWANDHCP,
 	},
-	"raNA": InfoRecord{
+	TagNAT: InfoRecord{
 		MaxLength:   1,
 		DataType:    TypeByte,
 		Encryption:  EncryptionUnencrypted,
 		Description: "NAT switch",
-		Tag:         "raNA",
+		Tag:         TagNAT,
 	},
-	"waIP": InfoRecord{
+	TagWANAddress: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeIPAddress,
 		Encryption:  EncryptionEncrypted,
 		Description: "Base station IP address",
-		Tag:         "waIP",
+		Tag:         TagWANAddress,
 	},
-	"waRA": InfoRecord{
+	TagRouterAddress: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeIPAddress,
 		Encryption:  EncryptionEncrypted,
 		Description: "Router IP address",
-		Tag:         "waRA",
+		Tag:         TagRouterAddress,
 	},
-	"waSM": InfoRecord{
+	TagWANSubnetMask: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeIPAddress,
 		Encryption:  EncryptionEncrypted,
 		Description: "Subnet mask",
-		Tag:         "waSM",
+		Tag:         TagWANSubnetMask,
 	},
-	"syCt": InfoRecord{
+	TagContact: InfoRecord{
 		MaxLength:   32,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "Contact person name",
-		Tag:         "syCt",
+		Tag:         TagContact,
 	},
-	"syNm": InfoRecord{
+	TagStationName: InfoRecord{
 		MaxLength:   32,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "Base station name",
-		Tag:         "syNm",
+		Tag:         TagStationName,
 	},
-	"syLo": InfoRecord{
+	TagLocation: InfoRecord{
 		MaxLength:   32,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "Base station location",
-		Tag:         "syLo",
+		Tag:         TagLocation,
 	},
-	"waDC": InfoRecord{
+	TagDHCPClientID: InfoRecord{
 		MaxLength:   32,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "DHCP client ID",
-		Tag:         "waDC",
+		Tag:         TagDHCPClientID,
 	},
-	"dhBg": InfoRecord{
+	TagDHCPRangeStart: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeIPAddress,
 		Encryption:  EncryptionEncrypted,
 		Description: "DHCP address range start",
-		Tag:         "dhBg",
+		Tag:         TagDHCPRangeStart,
 	},
-	"dhEn": InfoRecord{
+	TagDHCPRangeEnd: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeIPAddress,
 		Encryption:  EncryptionEncrypted,
 		Description: "DHCP address range end",
-		Tag:         "dhEn",
+		Tag:         TagDHCPRangeEnd,
 	},
-	"waD1": InfoRecord{
+	TagPrimaryDNS: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeIPAddress,
 		Encryption:  EncryptionEncrypted,
 		Description: "Primary DNS server",
-		Tag:         "waD1",
+		Tag:         TagPrimaryDNS,
 	},
-	"waD2": InfoRecord{
+	TagSecondaryDNS: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeIPAddress,
 		Encryption:  EncryptionEncrypted,
 		Description: "Secondary DNS server",
-		Tag:         "waD2",
+		Tag:         TagSecondaryDNS,
 	},
-	"dhLe": InfoRecord{
+	TagDHCPLeaseTime: InfoRecord{
 		MaxLength:   4,
 		DataType:    TypeUnsignedInteger,
 		Encryption:  EncryptionEncrypted,
 		Description: "DHCP lease time",
-		Tag:         "dhLe",
+		Tag:         TagDHCPLeaseTime,
 	},
-	"waDN": InfoRecord{
+	TagDomainName: InfoRecord{
 		MaxLength:   32,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "Domain name",
-		Tag:         "waDN",
+		Tag:         TagDomainName,
 	},
-	"pmTa": InfoRecord{
+	TagPortMappingTable: InfoRecord{
 		MaxLength:   16,
 		DataType:    TypeByteString,
 		Encryption:  EncryptionEncrypted,
 		Description: "Port mapping",
-		Tag:         "pmTa",
+		Tag:         TagPortMappingTable,
 	},
-	"moUN": InfoRecord{
+	TagDialUpUsername: InfoRecord{
 		MaxLength:   64,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "Dial-up username",
-		Tag:         "moUN",
+		Tag:         TagDialUpUsername,
 	},
-	"moPW": InfoRecord{
+	TagDialUpPassword: InfoRecord{
 		MaxLength:   64,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "Dial-up password",
-		Tag:         "moPW",
+		Tag:         TagDialUpPassword,
+		Secret:      true,
 	},
-	"peUN": InfoRecord{
+	TagPPPoEUsername: InfoRecord{
 		MaxLength:   64,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "PPPoE username",
-		Tag:         "peUN",
+		Tag:         TagPPPoEUsername,
 	},
-	"pePW": InfoRecord{
+	TagPPPoEPassword: InfoRecord{
 		MaxLength:   64,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "PPPoE password",
-		Tag:         "pePW",
+		Tag:         TagPPPoEPassword,
+		Secret:      true,
 	},
-	"peSN": InfoRecord{
+	TagPPPoEServiceName: InfoRecord{
 		MaxLength:   64,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionEncrypted,
 		Description: "PPPoE service name",
-		Tag:         "peSN",
+		Tag:         TagPPPoEServiceName,
 	},
-	"acRB": InfoRecord{
+	TagReboot: InfoRecord{
 		MaxLength:   0,
 		DataType:    TypeByte,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Reboot flag",
-		Tag:         "acRB",
+		Tag:         TagReboot,
+		ReadOnly:    true,
 	},
-	"buil": InfoRecord{
+	TagBuildHash: InfoRecord{
 		MaxLength:   40,
 		DataType:    TypeCharString,
 		Encryption:  EncryptionUnencrypted,
 		Description: "Software build hash",
-		Tag:         "buil",
+		Tag:         TagBuildHash,
+		ReadOnly:    true,
 	},
 }
 
-// GetInfoRecord TODO
-func GetInfoRecord(tag string) *InfoRecord {
-	tagStruct := tags[tag]
+// ReadCommunity reads the syPR property (Read community).
+func (a *Airport) ReadCommunity(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagReadCommunity)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
+}
+
+// SetReadCommunity writes the syPR property (Read community).
+func (a *Airport) SetReadCommunity(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagReadCommunity)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// ReadWriteCommunity reads the syPW property (Read/write community).
+func (a *Airport) ReadWriteCommunity(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagReadWriteCommunity)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
+}
+
+// SetReadWriteCommunity writes the syPW property (Read/write community).
+func (a *Airport) SetReadWriteCommunity(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagReadWriteCommunity)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// ConfigurationMode reads the waCV property (Configuration mode).
+func (a *Airport) ConfigurationMode(ctx context.Context) (ConfigMode, error) {
+	infoRecord, err := a.GetProperty(ctx, TagConfigurationMode)
+	if nil != err {
+		return 0, err
+	}
+
+	value, err := infoRecord.enum()
+	if nil != err {
+		return 0, err
+	}
+
+	return ConfigMode(value), nil
+}
+
+// SetConfigurationMode writes the waCV property (Configuration mode).
+func (a *Airport) SetConfigurationMode(ctx context.Context, value ConfigMode) error {
+	infoRecord := GetInfoRecord(TagConfigurationMode)
+	if err := infoRecord.setEnum(uint32(value)); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// WANInterface reads the waIn property (Ethernet/Modem switch 1).
+func (a *Airport) WANInterface(ctx context.Context) (WANInterface, error) {
+	infoRecord, err := a.GetProperty(ctx, TagWANInterface)
+	if nil != err {
+		return 0, err
+	}
+
+	value, err := infoRecord.enum()
+	if nil != err {
+		return 0, err
+	}
+
+	return WANInterface(value), nil
+}
+
+// SetWANInterface writes the waIn property (Ethernet/Modem switch 1).
+func (a *Airport) SetWANInterface(ctx context.Context, value WANInterface) error {
+	infoRecord := GetInfoRecord(TagWANInterface)
+	if err := infoRecord.setEnum(uint32(value)); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// MicrowaveRobustness reads the raRo property (Microwave robustness flag).
+func (a *Airport) MicrowaveRobustness(ctx context.Context) (bool, error) {
+	infoRecord, err := a.GetProperty(ctx, TagMicrowaveRobustness)
+	if nil != err {
+		return false, err
+	}
+
+	return infoRecord.Bool()
+}
+
+// SetMicrowaveRobustness writes the raRo property (Microwave robustness flag).
+func (a *Airport) SetMicrowaveRobustness(ctx context.Context, value bool) error {
+	infoRecord := GetInfoRecord(TagMicrowaveRobustness)
+	if err := infoRecord.SetBool(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// ClosedNetwork reads the raCl property (Closed network flag).
+func (a *Airport) ClosedNetwork(ctx context.Context) (bool, error) {
+	infoRecord, err := a.GetProperty(ctx, TagClosedNetwork)
+	if nil != err {
+		return false, err
+	}
+
+	return infoRecord.Bool()
+}
+
+// SetClosedNetwork writes the raCl property (Closed network flag).
+func (a *Airport) SetClosedNetwork(ctx context.Context, value bool) error {
+	infoRecord := GetInfoRecord(TagClosedNetwork)
+	if err := infoRecord.SetBool(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// AccessPointDensity reads the raDe property (Access point density).
+func (a *Airport) AccessPointDensity(ctx context.Context) (Density, error) {
+	infoRecord, err := a.GetProperty(ctx, TagAccessPointDensity)
+	if nil != err {
+		return 0, err
+	}
+
+	value, err := infoRecord.enum()
+	if nil != err {
+		return 0, err
+	}
+
+	return Density(value), nil
+}
+
+// SetAccessPointDensity writes the raDe property (Access point density).
+func (a *Airport) SetAccessPointDensity(ctx context.Context, value Density) error {
+	infoRecord := GetInfoRecord(TagAccessPointDensity)
+	if err := infoRecord.setEnum(uint32(value)); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// MulticastRate reads the raMu property (Multicast rate).
+func (a *Airport) MulticastRate(ctx context.Context) (MulticastRate, error) {
+	infoRecord, err := a.GetProperty(ctx, TagMulticastRate)
+	if nil != err {
+		return 0, err
+	}
+
+	value, err := infoRecord.enum()
+	if nil != err {
+		return 0, err
+	}
+
+	return MulticastRate(value), nil
+}
+
+// SetMulticastRate writes the raMu property (Multicast rate).
+func (a *Airport) SetMulticastRate(ctx context.Context, value MulticastRate) error {
+	infoRecord := GetInfoRecord(TagMulticastRate)
+	if err := infoRecord.setEnum(uint32(value)); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// WirelessChannel reads the raCh property (Wireless channel).
+func (a *Airport) WirelessChannel(ctx context.Context) (uint32, error) {
+	infoRecord, err := a.GetProperty(ctx, TagWirelessChannel)
+	if nil != err {
+		return 0, err
+	}
+
+	return infoRecord.Uint32()
+}
+
+// SetWirelessChannel writes the raCh property (Wireless channel).
+func (a *Airport) SetWirelessChannel(ctx context.Context, value uint32) error {
+	infoRecord := GetInfoRecord(TagWirelessChannel)
+	if err := infoRecord.SetUint32(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// ModemTimeout reads the moID property (Modem timeout).
+func (a *Airport) ModemTimeout(ctx context.Context) (uint32, error) {
+	infoRecord, err := a.GetProperty(ctx, TagModemTimeout)
+	if nil != err {
+		return 0, err
+	}
+
+	return infoRecord.Uint32()
+}
+
+// SetModemTimeout writes the moID property (Modem timeout).
+func (a *Airport) SetModemTimeout(ctx context.Context, value uint32) error {
+	infoRecord := GetInfoRecord(TagModemTimeout)
+	if err := infoRecord.SetUint32(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// DialingType reads the moPD property (Dialing type (tone or pulse)).
+func (a *Airport) DialingType(ctx context.Context) (bool, error) {
+	infoRecord, err := a.GetProperty(ctx, TagDialingType)
+	if nil != err {
+		return false, err
+	}
+
+	return infoRecord.Bool()
+}
+
+// SetDialingType writes the moPD property (Dialing type (tone or pulse)).
+func (a *Airport) SetDialingType(ctx context.Context, value bool) error {
+	infoRecord := GetInfoRecord(TagDialingType)
+	if err := infoRecord.SetBool(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// AutomaticDial reads the moAD property (Automatic dial).
+func (a *Airport) AutomaticDial(ctx context.Context) (bool, error) {
+	infoRecord, err := a.GetProperty(ctx, TagAutomaticDial)
+	if nil != err {
+		return false, err
+	}
+
+	return infoRecord.Bool()
+}
+
+// SetAutomaticDial writes the moAD property (Automatic dial).
+func (a *Airport) SetAutomaticDial(ctx context.Context, value bool) error {
+	infoRecord := GetInfoRecord(TagAutomaticDial)
+	if err := infoRecord.SetBool(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// PhoneCountryCode reads the moCC property (Phone country code).
+func (a *Airport) PhoneCountryCode(ctx context.Context) (uint32, error) {
+	infoRecord, err := a.GetProperty(ctx, TagPhoneCountryCode)
+	if nil != err {
+		return 0, err
+	}
+
+	return infoRecord.Uint32()
+}
+
+// SetPhoneCountryCode writes the moCC property (Phone country code).
+func (a *Airport) SetPhoneCountryCode(ctx context.Context, value uint32) error {
+	infoRecord := GetInfoRecord(TagPhoneCountryCode)
+	if err := infoRecord.SetUint32(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// ModemCountryIndex reads the moCI property (Modem country code combo box index).
+func (a *Airport) ModemCountryIndex(ctx context.Context) (uint32, error) {
+	infoRecord, err := a.GetProperty(ctx, TagModemCountryIndex)
+	if nil != err {
+		return 0, err
+	}
+
+	return infoRecord.Uint32()
+}
+
+// SetModemCountryIndex writes the moCI property (Modem country code combo box index).
+func (a *Airport) SetModemCountryIndex(ctx context.Context, value uint32) error {
+	infoRecord := GetInfoRecord(TagModemCountryIndex)
+	if err := infoRecord.SetUint32(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// NetworkName reads the raNm property (Network name).
+func (a *Airport) NetworkName(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagNetworkName)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
+}
+
+// SetNetworkName writes the raNm property (Network name).
+func (a *Airport) SetNetworkName(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagNetworkName)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// PrimaryPhoneNumber reads the moPN property (Primary phone number).
+func (a *Airport) PrimaryPhoneNumber(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagPrimaryPhoneNumber)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
+}
+
+// SetPrimaryPhoneNumber writes the moPN property (Primary phone number).
+func (a *Airport) SetPrimaryPhoneNumber(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagPrimaryPhoneNumber)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// SecondaryPhoneNumber reads the moAP property (Secondary phone number).
+func (a *Airport) SecondaryPhoneNumber(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagSecondaryPhoneNumber)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
+}
+
+// SetSecondaryPhoneNumber writes the moAP property (Secondary phone number).
+func (a *Airport) SetSecondaryPhoneNumber(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagSecondaryPhoneNumber)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// PPPoEIdleTimeout reads the peID property (PPPoE idle timeout).
+func (a *Airport) PPPoEIdleTimeout(ctx context.Context) (uint32, error) {
+	infoRecord, err := a.GetProperty(ctx, TagPPPoEIdleTimeout)
+	if nil != err {
+		return 0, err
+	}
+
+	return infoRecord.Uint32()
+}
+
+// SetPPPoEIdleTimeout writes the peID property (PPPoE idle timeout).
+func (a *Airport) SetPPPoEIdleTimeout(ctx context.Context, value uint32) error {
+	infoRecord := GetInfoRecord(TagPPPoEIdleTimeout)
+	if err := infoRecord.SetUint32(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// PPPoEAutoConnect reads the peAC property (PPPoE auto connect).
+func (a *Airport) PPPoEAutoConnect(ctx context.Context) (bool, error) {
+	infoRecord, err := a.GetProperty(ctx, TagPPPoEAutoConnect)
+	if nil != err {
+		return false, err
+	}
+
+	return infoRecord.Bool()
+}
+
+// SetPPPoEAutoConnect writes the peAC property (PPPoE auto connect).
+func (a *Airport) SetPPPoEAutoConnect(ctx context.Context, value bool) error {
+	infoRecord := GetInfoRecord(TagPPPoEAutoConnect)
+	if err := infoRecord.SetBool(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// PPPoEStayConnected reads the peSC property (PPPoE stay connected).
+func (a *Airport) PPPoEStayConnected(ctx context.Context) (bool, error) {
+	infoRecord, err := a.GetProperty(ctx, TagPPPoEStayConnected)
+	if nil != err {
+		return false, err
+	}
+
+	return infoRecord.Bool()
+}
+
+// SetPPPoEStayConnected writes the peSC property (PPPoE stay connected).
+func (a *Airport) SetPPPoEStayConnected(ctx context.Context, value bool) error {
+	infoRecord := GetInfoRecord(TagPPPoEStayConnected)
+	if err := infoRecord.SetBool(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// LANAddress reads the laIP property (Private LAN base station address).
+func (a *Airport) LANAddress(ctx context.Context) (net.IP, error) {
+	infoRecord, err := a.GetProperty(ctx, TagLANAddress)
+	if nil != err {
+		return nil, err
+	}
+
+	return infoRecord.IP()
+}
+
+// SetLANAddress writes the laIP property (Private LAN base station address).
+func (a *Airport) SetLANAddress(ctx context.Context, value net.IP) error {
+	infoRecord := GetInfoRecord(TagLANAddress)
+	if err := infoRecord.SetIP(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// LANSubnetMask reads the laSM property (Private LAN subnet mask).
+func (a *Airport) LANSubnetMask(ctx context.Context) (net.IP, error) {
+	infoRecord, err := a.GetProperty(ctx, TagLANSubnetMask)
+	if nil != err {
+		return nil, err
+	}
+
+	return infoRecord.IP()
+}
+
+// SetLANSubnetMask writes the laSM property (Private LAN subnet mask).
+func (a *Airport) SetLANSubnetMask(ctx context.Context, value net.IP) error {
+	infoRecord := GetInfoRecord(TagLANSubnetMask)
+	if err := infoRecord.SetIP(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// WirelessBridging reads the raWB property (Wireless to Ethernet bridging switch).
+func (a *Airport) WirelessBridging(ctx context.Context) (bool, error) {
+	infoRecord, err := a.GetProperty(ctx, TagWirelessBridging)
+	if nil != err {
+		return false, err
+	}
+
+	return infoRecord.Bool()
+}
+
+// SetWirelessBridging writes the raWB property (Wireless to Ethernet bridging switch).
+func (a *Airport) SetWirelessBridging(ctx context.Context, value bool) error {
+	infoRecord := GetInfoRecord(TagWirelessBridging)
+	if err := infoRecord.SetBool(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// AccessControlEnabled reads the acEn property (Access control switch).
+func (a *Airport) AccessControlEnabled(ctx context.Context) (bool, error) {
+	infoRecord, err := a.GetProperty(ctx, TagAccessControlEnabled)
+	if nil != err {
+		return false, err
+	}
+
+	return infoRecord.Bool()
+}
+
+// SetAccessControlEnabled writes the acEn property (Access control switch).
+func (a *Airport) SetAccessControlEnabled(ctx context.Context, value bool) error {
+	infoRecord := GetInfoRecord(TagAccessControlEnabled)
+	if err := infoRecord.SetBool(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// WirelessDHCP reads the raDS property (Wireless DHCP switch).
+func (a *Airport) WirelessDHCP(ctx context.Context) ([]byte, error) {
+	infoRecord, err := a.GetProperty(ctx, TagWirelessDHCP)
+	if nil != err {
+		return nil, err
+	}
+
+	return infoRecord.Bytes()
+}
+
+// SetWirelessDHCP writes the raDS property (Wireless DHCP switch).
+func (a *Airport) SetWirelessDHCP(ctx context.Context, value []byte) error {
+	infoRecord := GetInfoRecord(TagWirelessDHCP)
+	if err := infoRecord.SetBytes(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// LANDHCP reads the laDS property (LAN Ethernet DHCP switch).
+func (a *Airport) LANDHCP(ctx context.Context) (bool, error) {
+	infoRecord, err := a.GetProperty(ctx, TagLANDHCP)
+	if nil != err {
+		return false, err
+	}
+
+	return infoRecord.Bool()
+}
+
+// SetLANDHCP writes the laDS property (LAN Ethernet DHCP switch).
+func (a *Airport) SetLANDHCP(ctx context.Context, value bool) error {
+	infoRecord := GetInfoRecord(TagLANDHCP)
+	if err := infoRecord.SetBool(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// WANDHCP reads the waDS property (WAN Ethernet DHCP switch).
+func (a *Airport) WANDHCP(ctx context.Context) (bool, error) {
+	infoRecord, err := a.GetProperty(ctx, TagWANDHCP)
+	if nil != err {
+		return false, err
+	}
+
+	return infoRecord.Bool()
+}
+
+// SetWANDHCP writes the waDS property (WAN Ethernet DHCP switch).
+func (a *Airport) SetWANDHCP(ctx context.Context, value bool) error {
+	infoRecord := GetInfoRecord(TagWANDHCP)
+	if err := infoRecord.SetBool(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// NAT reads the raNA property (NAT switch).
+func (a *Airport) NAT(ctx context.Context) (bool, error) {
+	infoRecord, err := a.GetProperty(ctx, TagNAT)
+	if nil != err {
+		return false, err
+	}
+
+	return infoRecord.Bool()
+}
+
+// SetNAT writes the raNA property (NAT switch).
+func (a *Airport) SetNAT(ctx context.Context, value bool) error {
+	infoRecord := GetInfoRecord(TagNAT)
+	if err := infoRecord.SetBool(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// WANAddress reads the waIP property (Base station IP address).
+func (a *Airport) WANAddress(ctx context.Context) (net.IP, error) {
+	infoRecord, err := a.GetProperty(ctx, TagWANAddress)
+	if nil != err {
+		return nil, err
+	}
+
+	return infoRecord.IP()
+}
+
+// SetWANAddress writes the waIP property (Base station IP address).
+func (a *Airport) SetWANAddress(ctx context.Context, value net.IP) error {
+	infoRecord := GetInfoRecord(TagWANAddress)
+	if err := infoRecord.SetIP(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// RouterAddress reads the waRA property (Router IP address).
+func (a *Airport) RouterAddress(ctx context.Context) (net.IP, error) {
+	infoRecord, err := a.GetProperty(ctx, TagRouterAddress)
+	if nil != err {
+		return nil, err
+	}
+
+	return infoRecord.IP()
+}
+
+// SetRouterAddress writes the waRA property (Router IP address).
+func (a *Airport) SetRouterAddress(ctx context.Context, value net.IP) error {
+	infoRecord := GetInfoRecord(TagRouterAddress)
+	if err := infoRecord.SetIP(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// WANSubnetMask reads the waSM property (Subnet mask).
+func (a *Airport) WANSubnetMask(ctx context.Context) (net.IP, error) {
+	infoRecord, err := a.GetProperty(ctx, TagWANSubnetMask)
+	if nil != err {
+		return nil, err
+	}
+
+	return infoRecord.IP()
+}
+
+// SetWANSubnetMask writes the waSM property (Subnet mask).
+func (a *Airport) SetWANSubnetMask(ctx context.Context, value net.IP) error {
+	infoRecord := GetInfoRecord(TagWANSubnetMask)
+	if err := infoRecord.SetIP(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// Contact reads the syCt property (Contact person name).
+func (a *Airport) Contact(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagContact)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
+}
+
+// SetContact writes the syCt property (Contact person name).
+func (a *Airport) SetContact(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagContact)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// StationName reads the syNm property (Base station name).
+func (a *Airport) StationName(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagStationName)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
+}
+
+// SetStationName writes the syNm property (Base station name).
+func (a *Airport) SetStationName(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagStationName)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// Location reads the syLo property (Base station location).
+func (a *Airport) Location(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagLocation)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
+}
+
+// SetLocation writes the syLo property (Base station location).
+func (a *Airport) SetLocation(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagLocation)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// DHCPClientID reads the waDC property (DHCP client ID).
+func (a *Airport) DHCPClientID(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagDHCPClientID)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
+}
+
+// SetDHCPClientID writes the waDC property (DHCP client ID).
+func (a *Airport) SetDHCPClientID(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagDHCPClientID)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// DHCPRangeStart reads the dhBg property (DHCP address range start).
+func (a *Airport) DHCPRangeStart(ctx context.Context) (net.IP, error) {
+	infoRecord, err := a.GetProperty(ctx, TagDHCPRangeStart)
+	if nil != err {
+		return nil, err
+	}
+
+	return infoRecord.IP()
+}
+
+// SetDHCPRangeStart writes the dhBg property (DHCP address range start).
+func (a *Airport) SetDHCPRangeStart(ctx context.Context, value net.IP) error {
+	infoRecord := GetInfoRecord(TagDHCPRangeStart)
+	if err := infoRecord.SetIP(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// DHCPRangeEnd reads the dhEn property (DHCP address range end).
+func (a *Airport) DHCPRangeEnd(ctx context.Context) (net.IP, error) {
+	infoRecord, err := a.GetProperty(ctx, TagDHCPRangeEnd)
+	if nil != err {
+		return nil, err
+	}
+
+	return infoRecord.IP()
+}
+
+// SetDHCPRangeEnd writes the dhEn property (DHCP address range end).
+func (a *Airport) SetDHCPRangeEnd(ctx context.Context, value net.IP) error {
+	infoRecord := GetInfoRecord(TagDHCPRangeEnd)
+	if err := infoRecord.SetIP(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// PrimaryDNS reads the waD1 property (Primary DNS server).
+func (a *Airport) PrimaryDNS(ctx context.Context) (net.IP, error) {
+	infoRecord, err := a.GetProperty(ctx, TagPrimaryDNS)
+	if nil != err {
+		return nil, err
+	}
+
+	return infoRecord.IP()
+}
+
+// SetPrimaryDNS writes the waD1 property (Primary DNS server).
+func (a *Airport) SetPrimaryDNS(ctx context.Context, value net.IP) error {
+	infoRecord := GetInfoRecord(TagPrimaryDNS)
+	if err := infoRecord.SetIP(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// SecondaryDNS reads the waD2 property (Secondary DNS server).
+func (a *Airport) SecondaryDNS(ctx context.Context) (net.IP, error) {
+	infoRecord, err := a.GetProperty(ctx, TagSecondaryDNS)
+	if nil != err {
+		return nil, err
+	}
+
+	return infoRecord.IP()
+}
+
+// SetSecondaryDNS writes the waD2 property (Secondary DNS server).
+func (a *Airport) SetSecondaryDNS(ctx context.Context, value net.IP) error {
+	infoRecord := GetInfoRecord(TagSecondaryDNS)
+	if err := infoRecord.SetIP(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// DHCPLeaseTime reads the dhLe property (DHCP lease time).
+func (a *Airport) DHCPLeaseTime(ctx context.Context) (uint32, error) {
+	infoRecord, err := a.GetProperty(ctx, TagDHCPLeaseTime)
+	if nil != err {
+		return 0, err
+	}
+
+	return infoRecord.Uint32()
+}
+
+// SetDHCPLeaseTime writes the dhLe property (DHCP lease time).
+func (a *Airport) SetDHCPLeaseTime(ctx context.Context, value uint32) error {
+	infoRecord := GetInfoRecord(TagDHCPLeaseTime)
+	if err := infoRecord.SetUint32(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// DomainName reads the waDN property (Domain name).
+func (a *Airport) DomainName(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagDomainName)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
+}
+
+// SetDomainName writes the waDN property (Domain name).
+func (a *Airport) SetDomainName(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagDomainName)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// DialUpUsername reads the moUN property (Dial-up username).
+func (a *Airport) DialUpUsername(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagDialUpUsername)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
+}
+
+// SetDialUpUsername writes the moUN property (Dial-up username).
+func (a *Airport) SetDialUpUsername(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagDialUpUsername)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// DialUpPassword reads the moPW property (Dial-up password).
+func (a *Airport) DialUpPassword(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagDialUpPassword)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
+}
 
-	return &InfoRecord{
-		MaxLength:   tagStruct.MaxLength,
-		DataType:    tagStruct.DataType,
-		Encryption:  tagStruct.Encryption,
-		Description: tagStruct.Description,
-		Tag:         tagStruct.Tag,
+// SetDialUpPassword writes the moPW property (Dial-up password).
+func (a *Airport) SetDialUpPassword(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagDialUpPassword)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
 	}
+
+	return a.writeRecords(ctx, infoRecord)
 }
 
-// GetAllInfoRecords TODO
-func GetAllInfoRecords() map[string]*InfoRecord {
-	recs := make(map[string]*InfoRecord)
-	for tag := range tags {
-		recs[tag] = GetInfoRecord(tag)
+// PPPoEUsername reads the peUN property (PPPoE username).
+func (a *Airport) PPPoEUsername(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagPPPoEUsername)
+	if nil != err {
+		return "", err
 	}
-	return recs
+
+	return infoRecord.Text()
+}
+
+// SetPPPoEUsername writes the peUN property (PPPoE username).
+func (a *Airport) SetPPPoEUsername(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagPPPoEUsername)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// PPPoEPassword reads the pePW property (PPPoE password).
+func (a *Airport) PPPoEPassword(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagPPPoEPassword)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
+}
+
+// SetPPPoEPassword writes the pePW property (PPPoE password).
+func (a *Airport) SetPPPoEPassword(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagPPPoEPassword)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// PPPoEServiceName reads the peSN property (PPPoE service name).
+func (a *Airport) PPPoEServiceName(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagPPPoEServiceName)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
+}
+
+// SetPPPoEServiceName writes the peSN property (PPPoE service name).
+func (a *Airport) SetPPPoEServiceName(ctx context.Context, value string) error {
+	infoRecord := GetInfoRecord(TagPPPoEServiceName)
+	if err := infoRecord.SetText(value); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, infoRecord)
+}
+
+// BuildHash reads the buil property (Software build hash).
+func (a *Airport) BuildHash(ctx context.Context) (string, error) {
+	infoRecord, err := a.GetProperty(ctx, TagBuildHash)
+	if nil != err {
+		return "", err
+	}
+
+	return infoRecord.Text()
 }
@@ -0,0 +1,149 @@
+package airport
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// recordTypeNames maps lower case names, without the "Type" prefix, to
+// record types.
+var recordTypeNames = map[string]RecordType{
+	"charstring":                  TypeCharString,
+	"char":                        TypeCharString,
+	"string":                      TypeCharString,
+	"text":                        TypeCharString,
+	"ipaddress":                   TypeIPAddress,
+	"ip":                          TypeIPAddress,
+	"ipv4":                        TypeIPAddress,
+	"bytestring":                  TypeByteString,
+	"bytes":                       TypeByteString,
+	"hex":                         TypeByteString,
+	"phonenumber":                 TypePhoneNumber,
+	"phone":                       TypePhoneNumber,
+	"unsignedinteger":             TypeUnsignedInteger,
+	"uint":                        TypeUnsignedInteger,
+	"uint32":                      TypeUnsignedInteger,
+	"byte":                        TypeByte,
+	"littleendianunsignedinteger": TypeLittleEndianUnsignedInteger,
+	"uintle":                      TypeLittleEndianUnsignedInteger,
+	"leuint":                      TypeLittleEndianUnsignedInteger,
+}
+
+// recordEncryptionNames maps lower case names, without the "Encryption"
+// prefix, to record encryptions.
+var recordEncryptionNames = map[string]RecordEncryption{
+	"unencrypted": EncryptionUnencrypted,
+	"none":        EncryptionUnencrypted,
+	"plain":       EncryptionUnencrypted,
+	"encrypted":   EncryptionEncrypted,
+}
+
+// parseRecordType accepts the constant names returned by String as well as
+// short forms such as "ip", "uint" or "string", in any case.
+func parseRecordType(name string) (RecordType, error) {
+	key := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(name)), "type")
+	if dataType, ok := recordTypeNames[key]; ok {
+		return dataType, nil
+	}
+
+	return 0, fmt.Errorf("airport: unknown record type %q", name)
+}
+
+// parseRecordEncryption accepts the constant names returned by String as
+// well as "encrypted", "unencrypted", "none" and "plain", in any case.
+func parseRecordEncryption(name string) (RecordEncryption, error) {
+	key := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(name)), "encryption")
+	if encryption, ok := recordEncryptionNames[key]; ok {
+		return encryption, nil
+	}
+
+	return 0, fmt.Errorf("airport: unknown record encryption %q", name)
+}
+
+// MarshalText returns the constant name of the type.
+func (t RecordType) MarshalText() ([]byte, error) {
+	if t < TypeCharString || t > TypeLittleEndianUnsignedInteger {
+		return nil, fmt.Errorf("airport: unknown record type %d", int32(t))
+	}
+
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText parses a constant name or a short form such as "ip".
+func (t *RecordType) UnmarshalText(text []byte) error {
+	dataType, err := parseRecordType(string(text))
+	if nil != err {
+		return err
+	}
+
+	*t = dataType
+	return nil
+}
+
+// MarshalText returns the constant name of the encryption.
+func (e RecordEncryption) MarshalText() ([]byte, error) {
+	if EncryptionUnencrypted != e && EncryptionEncrypted != e {
+		return nil, fmt.Errorf("airport: unknown record encryption %d", int32(e))
+	}
+
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText parses a constant name or a short form such as "encrypted".
+func (e *RecordEncryption) UnmarshalText(text []byte) error {
+	encryption, err := parseRecordEncryption(string(text))
+	if nil != err {
+		return err
+	}
+
+	*e = encryption
+	return nil
+}
+
+// MarshalText returns the record as "tag=value", with the value in the form
+// String returns. Values that do not decode are an error, UnmarshalText could
+// not read their hex form back; JSON and backups keep them marked raw.
+func (i *InfoRecord) MarshalText() ([]byte, error) {
+	value, raw := i.text()
+	if raw {
+		return nil, fmt.Errorf("%w: %s: value %q does not decode as %s", ErrInvalidValue, i.Tag, value, i.DataType)
+	}
+
+	return []byte(i.Tag + "=" + value), nil
+}
+
+// UnmarshalText parses "tag=value" for a registered tag, validating the
+// value against the registry.
+func (i *InfoRecord) UnmarshalText(text []byte) error {
+	tag, value, ok := strings.Cut(string(text), "=")
+	if !ok {
+		return fmt.Errorf("%w: %q is not of the form tag=value", ErrInvalidValue, text)
+	}
+
+	infoRecord := lookupInfoRecord(tag)
+	if nil == infoRecord {
+		return fmt.Errorf("%w: %s", ErrUnknownTag, tag)
+	}
+
+	err := infoRecord.SetBytesFromString(value)
+	if nil != err {
+		return err
+	}
+
+	*i = *infoRecord
+	return nil
+}
+
+// LogValue keeps values of secret tags out of logs.
+func (i *InfoRecord) LogValue() slog.Value {
+	value := i.String()
+	if i.Secret || isSecret(i.Tag) {
+		value = "********"
+	}
+
+	return slog.GroupValue(
+		slog.String("tag", i.Tag),
+		slog.String("value", value),
+	)
+}
@@ -0,0 +1,116 @@
+package airport_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+func TestRecordTypeUnmarshalText(t *testing.T) {
+	tests := []struct {
+		name string
+		want airport.RecordType
+	}{
+		{"TypeCharString", airport.TypeCharString},
+		{"string", airport.TypeCharString},
+		{"Text", airport.TypeCharString},
+		{"ip", airport.TypeIPAddress},
+		{"IPv4", airport.TypeIPAddress},
+		{"hex", airport.TypeByteString},
+		{"bytes", airport.TypeByteString},
+		{"phone", airport.TypePhoneNumber},
+		{"uint", airport.TypeUnsignedInteger},
+		{" UINT32 ", airport.TypeUnsignedInteger},
+		{"byte", airport.TypeByte},
+		{"uintle", airport.TypeLittleEndianUnsignedInteger},
+		{"typeLittleEndianUnsignedInteger", airport.TypeLittleEndianUnsignedInteger},
+	}
+
+	for _, test := range tests {
+		var got airport.RecordType
+		if err := got.UnmarshalText([]byte(test.name)); nil != err || test.want != got {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want %v", test.name, got, err, test.want)
+		}
+	}
+
+	for _, name := range []string{"", "float", "Type"} {
+		var got airport.RecordType
+		if err := got.UnmarshalText([]byte(name)); nil == err {
+			t.Errorf("UnmarshalText(%q) = %v, want an error", name, got)
+		}
+	}
+
+	// the constant name round-trips
+	text, err := airport.TypeIPAddress.MarshalText()
+	if nil != err || "TypeIPAddress" != string(text) {
+		t.Errorf("MarshalText() = %s, %v", text, err)
+	}
+	if _, err := airport.RecordType(99).MarshalText(); nil == err {
+		t.Errorf("MarshalText() of an unknown type succeeded")
+	}
+}
+
+func TestRecordEncryptionUnmarshalText(t *testing.T) {
+	tests := []struct {
+		name string
+		want airport.RecordEncryption
+	}{
+		{"EncryptionEncrypted", airport.EncryptionEncrypted},
+		{"encrypted", airport.EncryptionEncrypted},
+		{"unencrypted", airport.EncryptionUnencrypted},
+		{"None", airport.EncryptionUnencrypted},
+		{"plain", airport.EncryptionUnencrypted},
+		{"encryptionUnencrypted", airport.EncryptionUnencrypted},
+	}
+
+	for _, test := range tests {
+		var got airport.RecordEncryption
+		if err := got.UnmarshalText([]byte(test.name)); nil != err || test.want != got {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want %v", test.name, got, err, test.want)
+		}
+	}
+
+	var got airport.RecordEncryption
+	if err := got.UnmarshalText([]byte("aes")); nil == err {
+		t.Errorf("UnmarshalText(aes) = %v, want an error", got)
+	}
+	if _, err := airport.RecordEncryption(1).MarshalText(); nil == err {
+		t.Errorf("MarshalText() of an unknown encryption succeeded")
+	}
+}
+
+func TestInfoRecordText(t *testing.T) {
+	for _, text := range []string{"syNm=Office", "laIP=10.0.1.1", "raCh=6", "raWE=0102030405", "raCl=01"} {
+		var infoRecord airport.InfoRecord
+		if err := infoRecord.UnmarshalText([]byte(text)); nil != err {
+			t.Errorf("UnmarshalText(%q) error = %v", text, err)
+			continue
+		}
+
+		got, err := infoRecord.MarshalText()
+		if nil != err || text != string(got) {
+			t.Errorf("MarshalText() = %s, %v, want %s", got, err, text)
+		}
+	}
+
+	for _, text := range []string{"syNm", "zzzz=1", "laIP=10.0.1", "raCh=x"} {
+		var infoRecord airport.InfoRecord
+		if err := infoRecord.UnmarshalText([]byte(text)); nil == err {
+			t.Errorf("UnmarshalText(%q) succeeded", text)
+		}
+	}
+
+	// a value that does not decode has no text form to read back
+	infoRecord := airport.GetInfoRecord("laIP")
+	infoRecord.Value = []byte{1, 2, 3}
+	if text, err := infoRecord.MarshalText(); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("MarshalText() of 3 address bytes = %s, %v, want ErrInvalidValue", text, err)
+	}
+
+	// but the record still shows it
+	if got := infoRecord.String(); !strings.Contains(got, "010203") {
+		t.Errorf("String() = %s, want the hex value", got)
+	}
+}
@@ -0,0 +1,195 @@
+package airport
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Uint32 returns the value of a TypeUnsignedInteger or
+// TypeLittleEndianUnsignedInteger record.
+func (i *InfoRecord) Uint32() (uint32, error) {
+	if err := i.checkType(TypeUnsignedInteger, TypeLittleEndianUnsignedInteger); nil != err {
+		return 0, err
+	}
+
+	if 4 != len(i.Value) {
+		return 0, fmt.Errorf("%w: %s: value is %d bytes, expected 4", ErrInvalidValue, i.Tag, len(i.Value))
+	}
+
+	if TypeLittleEndianUnsignedInteger == i.DataType {
+		return binary.LittleEndian.Uint32(i.Value), nil
+	}
+
+	return binary.BigEndian.Uint32(i.Value), nil
+}
+
+// SetUint32 sets the value of a TypeUnsignedInteger or
+// TypeLittleEndianUnsignedInteger record.
+func (i *InfoRecord) SetUint32(value uint32) error {
+	if err := i.checkType(TypeUnsignedInteger, TypeLittleEndianUnsignedInteger); nil != err {
+		return err
+	}
+
+	bytes := make([]byte, 4)
+	if TypeLittleEndianUnsignedInteger == i.DataType {
+		binary.LittleEndian.PutUint32(bytes, value)
+	} else {
+		binary.BigEndian.PutUint32(bytes, value)
+	}
+
+	return i.setChecked(bytes)
+}
+
+// IP returns the value of a TypeIPAddress record.
+func (i *InfoRecord) IP() (net.IP, error) {
+	if err := i.checkType(TypeIPAddress); nil != err {
+		return nil, err
+	}
+
+	if net.IPv4len != len(i.Value) {
+		return nil, fmt.Errorf("%w: %s: value is %d bytes, expected %d", ErrInvalidValue, i.Tag, len(i.Value), net.IPv4len)
+	}
+
+	return net.IPv4(i.Value[0], i.Value[1], i.Value[2], i.Value[3]), nil
+}
+
+// SetIP sets the value of a TypeIPAddress record, which only holds IPv4
+// addresses.
+func (i *InfoRecord) SetIP(ip net.IP) error {
+	if err := i.checkType(TypeIPAddress); nil != err {
+		return err
+	}
+
+	ipv4 := ip.To4()
+	if nil == ipv4 {
+		return fmt.Errorf("%w: %s: %v is not an IPv4 address", ErrInvalidValue, i.Tag, ip)
+	}
+
+	return i.setChecked(ipv4)
+}
+
+// Text returns the value of a TypeCharString or TypePhoneNumber record
+// without trailing NUL bytes.
+func (i *InfoRecord) Text() (string, error) {
+	if err := i.checkType(TypeCharString, TypePhoneNumber); nil != err {
+		return "", err
+	}
+
+	return strings.TrimRight(string(i.Value), "\x00"), nil
+}
+
+// SetText sets the value of a TypeCharString or TypePhoneNumber record. The
+// station needs room for a terminating NUL, so the text must be shorter than
+// MaxLength, unless the record is read-only.
+func (i *InfoRecord) SetText(value string) error {
+	if err := i.checkType(TypeCharString, TypePhoneNumber); nil != err {
+		return err
+	}
+
+	if maxLength := i.maxTextLength(); 0 < maxLength && int32(len(value)) > maxLength {
+		return fmt.Errorf("%w: %s: maximum %d characters", ErrInvalidValue, i.Tag, maxLength)
+	}
+
+	i.Value = []byte(value)
+	return nil
+}
+
+// Bool returns the value of a single TypeByte flag.
+func (i *InfoRecord) Bool() (bool, error) {
+	if err := i.checkType(TypeByte); nil != err {
+		return false, err
+	}
+
+	if 1 != len(i.Value) {
+		return false, fmt.Errorf("%w: %s: value is %d bytes, expected 1", ErrInvalidValue, i.Tag, len(i.Value))
+	}
+
+	return 0 != i.Value[0], nil
+}
+
+// SetBool sets the value of a single TypeByte flag.
+func (i *InfoRecord) SetBool(value bool) error {
+	if err := i.checkType(TypeByte); nil != err {
+		return err
+	}
+
+	if 0 < i.MaxLength && 1 != i.MaxLength {
+		return fmt.Errorf("%w: %s holds %d bytes, not a flag", ErrTypeMismatch, i.Tag, i.MaxLength)
+	}
+
+	if value {
+		i.Value = []byte{1}
+	} else {
+		i.Value = []byte{0}
+	}
+
+	return nil
+}
+
+// Bytes returns a copy of the value of a TypeByteString or TypeByte record.
+func (i *InfoRecord) Bytes() ([]byte, error) {
+	if err := i.checkType(TypeByteString, TypeByte); nil != err {
+		return nil, err
+	}
+
+	return append([]byte(nil), i.Value...), nil
+}
+
+// SetBytes sets the value of a TypeByteString or TypeByte record.
+func (i *InfoRecord) SetBytes(value []byte) error {
+	if err := i.checkType(TypeByteString, TypeByte); nil != err {
+		return err
+	}
+
+	return i.setChecked(append([]byte(nil), value...))
+}
+
+// enum returns the value of a 4 byte TypeByteString record holding a named
+// value, see enumTags.
+func (i *InfoRecord) enum() (uint32, error) {
+	if err := i.checkType(TypeByteString); nil != err {
+		return 0, err
+	}
+
+	if 4 != len(i.Value) {
+		return 0, fmt.Errorf("%w: %s: value is %d bytes, expected 4", ErrInvalidValue, i.Tag, len(i.Value))
+	}
+
+	return binary.BigEndian.Uint32(i.Value), nil
+}
+
+// setEnum sets the value of a 4 byte TypeByteString record holding a named
+// value.
+func (i *InfoRecord) setEnum(value uint32) error {
+	if err := i.checkType(TypeByteString); nil != err {
+		return err
+	}
+
+	bytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(bytes, value)
+
+	return i.setChecked(bytes)
+}
+
+// checkType returns ErrTypeMismatch unless the record has one of types.
+func (i *InfoRecord) checkType(types ...RecordType) error {
+	for _, dataType := range types {
+		if dataType == i.DataType {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s is %s", ErrTypeMismatch, i.Tag, i.DataType)
+}
+
+// setChecked sets the value after checking it against MaxLength.
+func (i *InfoRecord) setChecked(value []byte) error {
+	if 0 < i.MaxLength && int32(len(value)) > i.MaxLength {
+		return fmt.Errorf("%w: %s: value is %d bytes, maximum %d", ErrInvalidValue, i.Tag, len(value), i.MaxLength)
+	}
+
+	i.Value = value
+	return nil
+}
@@ -0,0 +1,190 @@
+package airport_test
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+func TestUint32(t *testing.T) {
+	tests := []struct {
+		name   string
+		record *airport.InfoRecord
+		value  []byte
+	}{
+		{"big endian", airport.NewInfoRecord("test", "", airport.TypeUnsignedInteger, airport.EncryptionUnencrypted, 4, nil), []byte{0, 0, 1, 2}},
+		{"little endian", airport.NewInfoRecord("test", "", airport.TypeLittleEndianUnsignedInteger, airport.EncryptionUnencrypted, 4, nil), []byte{2, 1, 0, 0}},
+	}
+
+	for _, test := range tests {
+		if err := test.record.SetUint32(0x102); nil != err {
+			t.Fatalf("%s: SetUint32() error = %v", test.name, err)
+		}
+		if !bytes.Equal(test.value, test.record.Value) {
+			t.Errorf("%s: SetUint32() value = %x, want %x", test.name, test.record.Value, test.value)
+		}
+
+		got, err := test.record.Uint32()
+		if nil != err || 0x102 != got {
+			t.Errorf("%s: Uint32() = %d, %v, want 258", test.name, got, err)
+		}
+	}
+
+	infoRecord := airport.GetInfoRecord("raCh")
+	infoRecord.Value = []byte{6}
+	if _, err := infoRecord.Uint32(); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("Uint32() of a short value error = %v, want ErrInvalidValue", err)
+	}
+
+	infoRecord = airport.GetInfoRecord("syNm")
+	if _, err := infoRecord.Uint32(); !errors.Is(err, airport.ErrTypeMismatch) {
+		t.Errorf("Uint32() of a string error = %v, want ErrTypeMismatch", err)
+	}
+	if err := infoRecord.SetUint32(1); !errors.Is(err, airport.ErrTypeMismatch) {
+		t.Errorf("SetUint32() of a string error = %v, want ErrTypeMismatch", err)
+	}
+
+	// the value does not fit a record shorter than 4 bytes
+	infoRecord = airport.NewInfoRecord("test", "", airport.TypeUnsignedInteger, airport.EncryptionUnencrypted, 2, nil)
+	if err := infoRecord.SetUint32(1); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("SetUint32() over MaxLength error = %v, want ErrInvalidValue", err)
+	}
+}
+
+func TestIP(t *testing.T) {
+	infoRecord := airport.GetInfoRecord("laIP")
+	if err := infoRecord.SetIP(net.ParseIP("10.0.1.1")); nil != err {
+		t.Fatal(err)
+	}
+	if !bytes.Equal([]byte{10, 0, 1, 1}, infoRecord.Value) {
+		t.Errorf("SetIP() value = %x", infoRecord.Value)
+	}
+
+	ip, err := infoRecord.IP()
+	if nil != err || !ip.Equal(net.IPv4(10, 0, 1, 1)) {
+		t.Errorf("IP() = %v, %v, want 10.0.1.1", ip, err)
+	}
+
+	if err := infoRecord.SetIP(net.ParseIP("fe80::1")); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("SetIP() of an IPv6 address error = %v, want ErrInvalidValue", err)
+	}
+
+	infoRecord.Value = []byte{1, 2, 3}
+	if _, err := infoRecord.IP(); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("IP() of a short value error = %v, want ErrInvalidValue", err)
+	}
+
+	infoRecord = airport.GetInfoRecord("raCh")
+	if _, err := infoRecord.IP(); !errors.Is(err, airport.ErrTypeMismatch) {
+		t.Errorf("IP() of an integer error = %v, want ErrTypeMismatch", err)
+	}
+	if err := infoRecord.SetIP(net.IPv4(10, 0, 1, 1)); !errors.Is(err, airport.ErrTypeMismatch) {
+		t.Errorf("SetIP() of an integer error = %v, want ErrTypeMismatch", err)
+	}
+}
+
+func TestText(t *testing.T) {
+	infoRecord := airport.GetInfoRecord("syNm")
+	if err := infoRecord.SetText("Office"); nil != err {
+		t.Fatal(err)
+	}
+
+	// stations pad text with NULs
+	infoRecord.Value = append(infoRecord.Value, 0, 0)
+	text, err := infoRecord.Text()
+	if nil != err || "Office" != text {
+		t.Errorf("Text() = %q, %v, want Office", text, err)
+	}
+
+	// one byte of the 32 is left for the terminating NUL
+	if err := infoRecord.SetText(strings.Repeat("x", 31)); nil != err {
+		t.Errorf("SetText() of 31 characters error = %v", err)
+	}
+	if err := infoRecord.SetText(strings.Repeat("x", 32)); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("SetText() of 32 characters error = %v, want ErrInvalidValue", err)
+	}
+
+	readOnly := airport.NewInfoRecord("test", "", airport.TypeCharString, airport.EncryptionUnencrypted, 4, nil)
+	readOnly.ReadOnly = true
+	if err := readOnly.SetText("abcd"); nil != err {
+		t.Errorf("SetText() filling a read-only record error = %v", err)
+	}
+	if err := readOnly.SetText("abcde"); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("SetText() over MaxLength error = %v, want ErrInvalidValue", err)
+	}
+
+	infoRecord = airport.GetInfoRecord("laIP")
+	if _, err := infoRecord.Text(); !errors.Is(err, airport.ErrTypeMismatch) {
+		t.Errorf("Text() of an address error = %v, want ErrTypeMismatch", err)
+	}
+	if err := infoRecord.SetText("x"); !errors.Is(err, airport.ErrTypeMismatch) {
+		t.Errorf("SetText() of an address error = %v, want ErrTypeMismatch", err)
+	}
+}
+
+func TestBool(t *testing.T) {
+	infoRecord := airport.GetInfoRecord("raCl")
+	for _, value := range []bool{true, false} {
+		if err := infoRecord.SetBool(value); nil != err {
+			t.Fatal(err)
+		}
+
+		got, err := infoRecord.Bool()
+		if nil != err || value != got {
+			t.Errorf("Bool() = %v, %v, want %v", got, err, value)
+		}
+	}
+
+	infoRecord.Value = []byte{0, 1}
+	if _, err := infoRecord.Bool(); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("Bool() of 2 bytes error = %v, want ErrInvalidValue", err)
+	}
+
+	// raDS is a TypeByte record of 10 bytes, not a flag
+	if err := airport.GetInfoRecord("raDS").SetBool(true); !errors.Is(err, airport.ErrTypeMismatch) {
+		t.Errorf("SetBool() of a 10 byte record error = %v, want ErrTypeMismatch", err)
+	}
+
+	infoRecord = airport.GetInfoRecord("syNm")
+	if _, err := infoRecord.Bool(); !errors.Is(err, airport.ErrTypeMismatch) {
+		t.Errorf("Bool() of a string error = %v, want ErrTypeMismatch", err)
+	}
+	if err := infoRecord.SetBool(true); !errors.Is(err, airport.ErrTypeMismatch) {
+		t.Errorf("SetBool() of a string error = %v, want ErrTypeMismatch", err)
+	}
+}
+
+func TestBytes(t *testing.T) {
+	infoRecord := airport.GetInfoRecord("raWE")
+	value := []byte{1, 2, 3, 4, 5}
+	if err := infoRecord.SetBytes(value); nil != err {
+		t.Fatal(err)
+	}
+
+	// neither side shares the slice with the record
+	value[0] = 0xFF
+	got, err := infoRecord.Bytes()
+	if nil != err || !bytes.Equal([]byte{1, 2, 3, 4, 5}, got) {
+		t.Errorf("Bytes() = %x, %v, want 0102030405", got, err)
+	}
+	got[0] = 0xFF
+	if 1 != infoRecord.Value[0] {
+		t.Errorf("Bytes() returned the record's value")
+	}
+
+	if err := infoRecord.SetBytes(make([]byte, 14)); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("SetBytes() over MaxLength error = %v, want ErrInvalidValue", err)
+	}
+
+	infoRecord = airport.GetInfoRecord("raCh")
+	if _, err := infoRecord.Bytes(); !errors.Is(err, airport.ErrTypeMismatch) {
+		t.Errorf("Bytes() of an integer error = %v, want ErrTypeMismatch", err)
+	}
+	if err := infoRecord.SetBytes([]byte{1}); !errors.Is(err, airport.ErrTypeMismatch) {
+		t.Errorf("SetBytes() of an integer error = %v, want ErrTypeMismatch", err)
+	}
+}
@@ -0,0 +1,119 @@
+package airport
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// WEPKeySize is the length of a WEP key in bytes.
+type WEPKeySize int
+
+const (
+	// WEPKey40 is the size of a 40 bit key.
+	WEPKey40 WEPKeySize = 5
+	// WEPKey128 is the size of a 128 bit key, which has 104 secret bits.
+	WEPKey128 WEPKeySize = 13
+)
+
+func (s WEPKeySize) valid() bool {
+	return WEPKey40 == s || WEPKey128 == s
+}
+
+// GenerateWEPKey returns a random key of the given size.
+func GenerateWEPKey(size WEPKeySize) ([]byte, error) {
+	if !size.valid() {
+		return nil, fmt.Errorf("%w: raWE: unsupported key size %d", ErrInvalidValue, size)
+	}
+
+	key := make([]byte, size)
+	if _, err := rand.Read(key); nil != err {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// WEPKeyFromPassphrase derives a key from a network password: the
+// passphrase is repeated to fill 64 bytes, hashed with MD5 and the hash
+// truncated to the key size. This is the common 128-bit scheme; 40-bit keys
+// are a truncation of it and differ from the keys other tools derive for
+// 40-bit WEP. Keys are not checked against Apple's tools, enter the hex key
+// they show to be sure both sides agree.
+func WEPKeyFromPassphrase(passphrase string, size WEPKeySize) ([]byte, error) {
+	if !size.valid() {
+		return nil, fmt.Errorf("%w: raWE: unsupported key size %d", ErrInvalidValue, size)
+	}
+
+	if "" == passphrase {
+		return nil, fmt.Errorf("%w: raWE: empty passphrase", ErrInvalidValue)
+	}
+
+	buffer := []byte(strings.Repeat(passphrase, 64/len(passphrase)+1))[:64]
+	sum := md5.Sum(buffer)
+
+	return sum[:size], nil
+}
+
+// ParseWEPKey decodes a key given as 10 or 26 hex digits.
+func ParseWEPKey(hexKey string) ([]byte, error) {
+	key, err := hex.DecodeString(hexKey)
+	if nil != err {
+		return nil, fmt.Errorf("%w: raWE: %q is not a hex string", ErrInvalidValue, hexKey)
+	}
+
+	if err = checkWEPKey(key); nil != err {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func checkWEPKey(key []byte) error {
+	if !WEPKeySize(len(key)).valid() {
+		return fmt.Errorf("%w: raWE: key is %d bytes, expected %d or %d", ErrInvalidValue, len(key), WEPKey40, WEPKey128)
+	}
+
+	if maxLength := lookupInfoRecord(TagEncryptionKey).MaxLength; int32(len(key)) > maxLength {
+		return fmt.Errorf("%w: raWE: key is %d bytes, maximum %d", ErrInvalidValue, len(key), maxLength)
+	}
+
+	return nil
+}
+
+// SetWEPKey enables WEP with key, writing the encryption switch and the key
+// together so the station never runs with a mismatched pair.
+func (a *Airport) SetWEPKey(ctx context.Context, key []byte) error {
+	if err := checkWEPKey(key); nil != err {
+		return err
+	}
+
+	mode := lookupInfoRecord(TagEncryptionSwitch)
+	encryptionMode := EncryptionModeWEP128
+	if WEPKey40 == WEPKeySize(len(key)) {
+		encryptionMode = EncryptionModeWEP40
+	}
+	if err := mode.setEnum(uint32(encryptionMode)); nil != err {
+		return err
+	}
+
+	keyRecord := lookupInfoRecord(TagEncryptionKey)
+	if err := keyRecord.SetBytes(key); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, mode, keyRecord)
+}
+
+// DisableWEP turns encryption off and clears the key.
+func (a *Airport) DisableWEP(ctx context.Context) error {
+	mode := lookupInfoRecord(TagEncryptionSwitch)
+	if err := mode.setEnum(uint32(EncryptionModeOff)); nil != err {
+		return err
+	}
+
+	return a.writeRecords(ctx, mode, lookupInfoRecord(TagEncryptionKey))
+}
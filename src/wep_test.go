@@ -0,0 +1,94 @@
+package airport_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	airport "github.com/jutaz/go-airport/src"
+)
+
+func TestWEPKeyFromPassphrase(t *testing.T) {
+	// computed separately from the MD5 of the passphrase repeated to 64 bytes
+	tests := []struct {
+		passphrase string
+		size       airport.WEPKeySize
+		key        string
+	}{
+		{"test", airport.WEPKey40, "9fdf3bfdfb"},
+		{"test", airport.WEPKey128, "9fdf3bfdfb10afeb0925ef9605"},
+		{"AirPort Network", airport.WEPKey128, "199e75bc6ee8a7f55c54049e6b"},
+	}
+
+	for _, test := range tests {
+		key, err := airport.WEPKeyFromPassphrase(test.passphrase, test.size)
+		if nil != err {
+			t.Errorf("WEPKeyFromPassphrase(%q, %d): %v", test.passphrase, test.size, err)
+			continue
+		}
+
+		if got := hex.EncodeToString(key); test.key != got {
+			t.Errorf("WEPKeyFromPassphrase(%q, %d) = %s, want %s", test.passphrase, test.size, got, test.key)
+		}
+	}
+
+	if _, err := airport.WEPKeyFromPassphrase("", airport.WEPKey40); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("empty passphrase error = %v, want ErrInvalidValue", err)
+	}
+
+	if _, err := airport.WEPKeyFromPassphrase("test", 8); !errors.Is(err, airport.ErrInvalidValue) {
+		t.Errorf("key size 8 error = %v, want ErrInvalidValue", err)
+	}
+}
+
+func TestGenerateWEPKey(t *testing.T) {
+	key, err := airport.GenerateWEPKey(airport.WEPKey128)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if int(airport.WEPKey128) != len(key) {
+		t.Errorf("GenerateWEPKey() is %d bytes, want %d", len(key), airport.WEPKey128)
+	}
+}
+
+func TestParseWEPKey(t *testing.T) {
+	if key, err := airport.ParseWEPKey("0102030405"); nil != err || 5 != len(key) {
+		t.Errorf("ParseWEPKey() = %x, %v", key, err)
+	}
+
+	for _, hexKey := range []string{"01020304", "xx02030405", "010203040506"} {
+		if _, err := airport.ParseWEPKey(hexKey); !errors.Is(err, airport.ErrInvalidValue) {
+			t.Errorf("ParseWEPKey(%q) error = %v, want ErrInvalidValue", hexKey, err)
+		}
+	}
+}
+
+func TestSetWEPKey(t *testing.T) {
+	ctx := context.Background()
+	server := newServer(t, "secret")
+	key, _ := airport.ParseWEPKey("0102030405")
+
+	if err := server.Airport().SetWEPKey(ctx, key); nil != err {
+		t.Fatal(err)
+	}
+
+	if got := server.Get("raWM").String(); "wep40" != got {
+		t.Errorf("raWM = %q, want wep40", got)
+	}
+	if got := server.Get("raWE").String(); "0102030405" != got {
+		t.Errorf("raWE = %q, want 0102030405", got)
+	}
+
+	if err := server.Airport().DisableWEP(ctx); nil != err {
+		t.Fatal(err)
+	}
+
+	if got := server.Get("raWM").String(); "off" != got {
+		t.Errorf("raWM = %q, want off", got)
+	}
+	if got := server.Get("raWE").Value; 0 != len(got) {
+		t.Errorf("raWE = %x, want empty", got)
+	}
+}